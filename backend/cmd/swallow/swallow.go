@@ -3,15 +3,25 @@ package main
 import (
 	"SwallowGo/internal/api"
 	"SwallowGo/internal/api/spec"
-	"SwallowGo/internal/mailer/mailpit"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/lifecycle"
+	"SwallowGo/internal/mailer"
+	"SwallowGo/internal/oidc"
+	"SwallowGo/internal/outbox"
+	"SwallowGo/internal/storage"
+	"SwallowGo/internal/tokens"
+	"SwallowGo/internal/unfurl"
 	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// Trip time zones must resolve even where the host has no zone database.
+	_ "time/tzdata"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -62,14 +72,125 @@ func run(ctx context.Context) error {
 		return err
 	}
 
+	secret := os.Getenv("SWALLOWGO_TOKEN_SECRET")
+	if secret == "" {
+		return errors.New("SWALLOWGO_TOKEN_SECRET is not set")
+	}
+	signer := tokens.NewSigner([]byte(secret), tokens.DefaultTTL)
+
+	sessionSecret := os.Getenv("SWALLOWGO_SESSION_SECRET")
+	if sessionSecret == "" {
+		return errors.New("SWALLOWGO_SESSION_SECRET is not set")
+	}
+	sessions := auth.NewSessions([]byte(sessionSecret), auth.DefaultSessionTTL)
+
+	publicURL := os.Getenv("SWALLOWGO_PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:8080"
+	}
+	baseURL, err := url.Parse(publicURL)
+	if err != nil {
+		return fmt.Errorf("invalid SWALLOWGO_PUBLIC_URL: %w", err)
+	}
+
+	mailerCfg, err := mailer.ConfigFromEnv()
+	if err != nil {
+		return err
+	}
+	transport, err := mailer.NewTransport(mailerCfg, logger)
+	if err != nil {
+		return err
+	}
+
+	dispatcher := outbox.NewDispatcher(pool, mailer.New(pool, signer, baseURL, mailerCfg.From, transport), logger, outbox.DefaultConfig)
+	dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+	dispatchDone := make(chan struct{})
+	go func() {
+		defer close(dispatchDone)
+		dispatcher.Run(dispatchCtx)
+	}()
+
+	// Registered before the server shutdown so it runs after it: no request
+	// can enqueue anything anymore while the dispatcher drains.
+	defer func() {
+		const timeout = 30 * time.Second
+		stopDispatch()
+
+		select {
+		case <-dispatchDone:
+		case <-time.After(timeout):
+			logger.Error("timed out waiting for the outbox dispatcher to drain")
+		}
+	}()
+
+	scheduler := lifecycle.NewScheduler(pool, logger, lifecycle.DefaultConfig)
+	scheduleCtx, stopSchedule := context.WithCancel(context.Background())
+	scheduleDone := make(chan struct{})
+	go func() {
+		defer close(scheduleDone)
+		scheduler.Run(scheduleCtx)
+	}()
+
+	defer func() {
+		stopSchedule()
+		<-scheduleDone
+	}()
+
+	fetcher := unfurl.NewCache(unfurl.NewHTTPFetcher(unfurl.DefaultFetchConfig), unfurl.DefaultCacheConfig)
+	unfurler := unfurl.NewWorker(pool, fetcher, logger, unfurl.DefaultConfig)
+	unfurlCtx, stopUnfurl := context.WithCancel(context.Background())
+	unfurlDone := make(chan struct{})
+	go func() {
+		defer close(unfurlDone)
+		unfurler.Run(unfurlCtx)
+	}()
+
+	defer func() {
+		stopUnfurl()
+		<-unfurlDone
+	}()
+
+	storageCfg, err := storage.ConfigFromEnv()
+	if err != nil {
+		return err
+	}
+	files, err := storage.New(storageCfg)
+	if err != nil {
+		return err
+	}
+
+	oidcCfg, err := oidc.ConfigFromEnv(baseURL)
+	if err != nil {
+		return err
+	}
+	var provider *oidc.Provider
+	if oidcCfg.Enabled() {
+		provider = oidc.NewProvider(oidcCfg, nil)
+	}
+
 	si := api.NewApi(
 		pool,
 		logger,
-		mailpit.NewMailpit(pool),
+		signer,
+		files,
+		sessions,
+		provider,
 	)
+	doc, err := spec.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+	validateRequests, err := api.ValidateRequests(doc)
+	if err != nil {
+		return err
+	}
+	if err := api.CheckPolicies(doc); err != nil {
+		return err
+	}
+
 	r := chi.NewMux()
-	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
-	r.Mount("/", spec.Handler(&si))
+	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger), validateRequests, api.Authenticate(sessions))
+	r.Mount("/", spec.Handler(&si, spec.WithErrorHandler(api.ParamErrorHandler), spec.WithMiddlewares(si.Policies())))
 
 	srv := &http.Server{
 		Addr:         ":8080",
@@ -6,6 +6,7 @@ toolchain go1.22.5
 
 require (
 	github.com/discord-gophers/goapi-gen v0.3.0
+	github.com/gabriel-vasile/mimetype v1.4.3
 	github.com/getkin/kin-openapi v0.126.0
 	github.com/go-chi/chi v1.5.5
 	github.com/go-chi/chi/v5 v5.1.0
@@ -15,11 +16,11 @@ require (
 	github.com/jackc/pgx/v5 v5.6.0
 	github.com/phenpessoa/gutils v0.0.0-20240130030144-d391b9329afd
 	go.uber.org/zap v1.27.0
+	golang.org/x/net v0.21.0
 )
 
 require (
 	github.com/ajg/form v1.5.1 // indirect
-	github.com/gabriel-vasile/mimetype v1.4.3 // indirect
 	github.com/go-openapi/jsonpointer v0.21.0 // indirect
 	github.com/go-openapi/swag v0.23.0 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
@@ -36,7 +37,6 @@ require (
 	github.com/wneessen/go-mail v0.4.2
 	go.uber.org/multierr v1.11.0 // indirect
 	golang.org/x/crypto v0.25.0 // indirect
-	golang.org/x/net v0.21.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
 	golang.org/x/text v0.16.0 // indirect
@@ -2,17 +2,23 @@ package api
 
 import (
 	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/oidc"
 	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/storage"
+	"SwallowGo/internal/tokens"
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/discord-gophers/goapi-gen/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"go.uber.org/zap"
@@ -21,27 +27,52 @@ import (
 type store interface {
 	// trip
 	GetTrip(ctx context.Context, tripID uuid.UUID) (pgstore.Trip, error)
+	ListUserTrips(ctx context.Context, arg pgstore.ListUserTripsParams) ([]pgstore.Trip, error)
 	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest) (uuid.UUID, error)
 	PutTrip(ctx context.Context, pool *pgxpool.Pool, params spec.UpdateTripRequest, tripID uuid.UUID) error
-	ConfirmTrip(ctx context.Context, tripID uuid.UUID) error
+	DeleteTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error
+	CancelTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CancelTripRequest, tripID uuid.UUID) error
+	TransitionTrip(ctx context.Context, tripID uuid.UUID, to pgstore.TripStatus) error
+	GetTripStatusTransitions(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripStatusTransitionsRow, error)
+	ConfirmTripWithToken(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, tokenHash []byte) error
 	//Participant
 	GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
-	ConfirmParticipant(ctx context.Context, participantID uuid.UUID) error
+	RespondToInvitationWithToken(ctx context.Context, pool *pgxpool.Pool, participantID uuid.UUID, tokenHash []byte, status pgstore.RsvpStatus, response spec.ConfirmParticipantRequest) error
+	GetConfirmationToken(ctx context.Context, arg pgstore.GetConfirmationTokenParams) (pgstore.GetConfirmationTokenRow, error)
 	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
+	CountTripRsvps(ctx context.Context, tripID uuid.UUID) (pgstore.CountTripRsvpsRow, error)
 	InsertInviteParticipantToTrip(ctx context.Context, pool *pgxpool.Pool, params spec.InviteParticipantRequest, tripID uuid.UUID) (uuid.UUID, error)
+	BulkInviteParticipantsToTrip(ctx context.Context, pool *pgxpool.Pool, rows []spec.BulkInviteRow, tripID uuid.UUID) ([]pgstore.InviteOutcome, error)
+	RemoveParticipant(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID, notify bool) error
+	RevokeInvitation(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID, notify bool) error
+	GetTripParticipantRole(ctx context.Context, arg pgstore.GetTripParticipantRoleParams) (pgstore.GetTripParticipantRoleRow, error)
+	UpdateParticipantRole(ctx context.Context, arg pgstore.UpdateParticipantRoleParams) (int64, error)
+	RequestOwnershipTransfer(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID) error
+	WithdrawOwnershipTransfer(ctx context.Context, tripID uuid.UUID) error
+	GetPendingOwnershipTransfer(ctx context.Context, tripID uuid.UUID) (pgstore.OwnershipTransfer, error)
+	AcceptOwnershipWithToken(ctx context.Context, pool *pgxpool.Pool, participantID uuid.UUID, tokenHash []byte) error
 	//Activities
     InsertActivity(ctx context.Context, pool *pgxpool.Pool, params spec.CreateActivityRequest, tripID uuid.UUID) (uuid.UUID, error)
 	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	PatchActivity(ctx context.Context, pool *pgxpool.Pool, params spec.PatchActivityRequest, tripID uuid.UUID, activityID uuid.UUID) error
+	DeleteActivity(ctx context.Context, arg pgstore.DeleteActivityParams) (int64, error)
 	//Links
 	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error)
-	InsertTripsTripIDLinks(ctx context.Context, pool *pgxpool.Pool, params spec.CreateLinkRequest, tripID uuid.UUID) (uuid.UUID, error)
-}
-
-type mailer interface {
-	SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error
-	ReSendConfirmTripEmailToTripOwner(tripID uuid.UUID) error
-	SendConfirmTripEmailToTripinvitations(tripID uuid.UUID) error
-	SendConfirmTripEmailToTripinvitation(tripID uuid.UUID,ParticipantID uuid.UUID) error
+	InsertTripsTripIDLinks(ctx context.Context, params spec.CreateLinkRequest, tripID uuid.UUID, addedBy string) (uuid.UUID, error)
+	ReplaceTripLink(ctx context.Context, params spec.UpdateLinkRequest, tripID uuid.UUID, linkID uuid.UUID) error
+	DeleteTripLink(ctx context.Context, arg pgstore.DeleteTripLinkParams) (int64, error)
+	//Attachments
+	GetTripActivity(ctx context.Context, arg pgstore.GetTripActivityParams) (pgstore.Activity, error)
+	GetTripAttachments(ctx context.Context, arg pgstore.GetTripAttachmentsParams) ([]pgstore.Attachment, error)
+	GetTripAttachment(ctx context.Context, arg pgstore.GetTripAttachmentParams) (pgstore.Attachment, error)
+	CreateAttachment(ctx context.Context, arg pgstore.CreateAttachmentParams) error
+	DeleteTripAttachment(ctx context.Context, arg pgstore.DeleteTripAttachmentParams) (int64, error)
+	//Users
+	RequestMagicLink(ctx context.Context, email string) error
+	GetLoginToken(ctx context.Context, arg pgstore.GetLoginTokenParams) (pgstore.GetLoginTokenRow, error)
+	SignInWithToken(ctx context.Context, pool *pgxpool.Pool, loginID uuid.UUID, tokenHash []byte) (pgstore.User, error)
+	GetUser(ctx context.Context, id uuid.UUID) (pgstore.User, error)
+	SignInWithIdentity(ctx context.Context, pool *pgxpool.Pool, identity pgstore.Identity) (pgstore.User, error)
 }
 
 type API struct {
@@ -49,67 +80,247 @@ type API struct {
 	logger *zap.Logger
 	validator *validator.Validate
 	pool *pgxpool.Pool
-	mailer    mailer
+	signer    tokens.Signer
+	files     storage.Storage
+	sessions  auth.Sessions
+	// provider is nil unless sign-in through OpenID Connect is configured.
+	provider  *oidc.Provider
+}
+
+func NewApi(pool *pgxpool.Pool, logger *zap.Logger, signer tokens.Signer, files storage.Storage, sessions auth.Sessions, provider *oidc.Provider) API {
+	return API{pgstore.New(pool), logger, newValidator(), pool, signer, files, sessions, provider}
 }
 
-func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer) API {
-	validator := validator.New(validator.WithRequiredStructEnabled())
-	return API{pgstore.New(pool), logger, validator, pool, mailer}
+// Shows the invitation a confirm link from the e-mail answers.
+// (GET /participants/{participantId}/confirm)
+func (api API) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params spec.GetParticipantsParticipantIDConfirmParams) *spec.Response {
+	preview, res := api.previewInvitation(r, participantID, params.Token, pgstore.RsvpStatusAccepted, errorResponses{
+		badRequest: spec.GetParticipantsParticipantIDConfirmJSON400Response,
+		notFound:   spec.GetParticipantsParticipantIDConfirmJSON404Response,
+		internal:   spec.GetParticipantsParticipantIDConfirmJSON500Response,
+	})
+	if res != nil {
+		return res
+	}
+
+	return spec.GetParticipantsParticipantIDConfirmJSON200Response(preview)
 }
 
 // Confirms a participant on a trip.
 // (PATCH /participants/{participantId}/confirm)
-func (api API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+func (api API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params spec.PatchParticipantsParticipantIDConfirmParams) *spec.Response {
+	// The body is optional, participants may fill in their profile while confirming.
+	var body spec.ConfirmParticipantRequest
+	if res := api.decodeOptionalBody(w, r, &body, errorResponses{
+		badRequest:    spec.PatchParticipantsParticipantIDConfirmJSON400Response,
+		unprocessable: spec.PatchParticipantsParticipantIDConfirmJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	if res := api.respondToInvitation(r, participantID, params.Token, pgstore.RsvpStatusAccepted, body, errorResponses{
+		badRequest: spec.PatchParticipantsParticipantIDConfirmJSON400Response,
+		notFound:   spec.PatchParticipantsParticipantIDConfirmJSON404Response,
+		conflict:   spec.PatchParticipantsParticipantIDConfirmJSON409Response,
+		internal:   spec.PatchParticipantsParticipantIDConfirmJSON500Response,
+	}); res != nil {
+		return res
+	}
+
+	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+}
+
+// Shows the invitation a decline link from the e-mail answers.
+// (GET /participants/{participantId}/decline)
+func (api API) GetParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string, params spec.GetParticipantsParticipantIDDeclineParams) *spec.Response {
+	preview, res := api.previewInvitation(r, participantID, params.Token, pgstore.RsvpStatusDeclined, errorResponses{
+		badRequest: spec.GetParticipantsParticipantIDDeclineJSON400Response,
+		notFound:   spec.GetParticipantsParticipantIDDeclineJSON404Response,
+		internal:   spec.GetParticipantsParticipantIDDeclineJSON500Response,
+	})
+	if res != nil {
+		return res
+	}
+
+	return spec.GetParticipantsParticipantIDDeclineJSON200Response(preview)
+}
+
+// Declines a trip invitation.
+// (PATCH /participants/{participantId}/decline)
+func (api API) PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string, params spec.PatchParticipantsParticipantIDDeclineParams) *spec.Response {
+	var body spec.RsvpRequest
+	if res := api.decodeOptionalBody(w, r, &body, errorResponses{
+		badRequest:    spec.PatchParticipantsParticipantIDDeclineJSON400Response,
+		unprocessable: spec.PatchParticipantsParticipantIDDeclineJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	if res := api.respondToInvitation(r, participantID, params.Token, pgstore.RsvpStatusDeclined, spec.ConfirmParticipantRequest{Comment: body.Comment}, errorResponses{
+		badRequest: spec.PatchParticipantsParticipantIDDeclineJSON400Response,
+		notFound:   spec.PatchParticipantsParticipantIDDeclineJSON404Response,
+		conflict:   spec.PatchParticipantsParticipantIDDeclineJSON409Response,
+		internal:   spec.PatchParticipantsParticipantIDDeclineJSON500Response,
+	}); res != nil {
+		return res
+	}
+
+	return spec.PatchParticipantsParticipantIDDeclineJSON204Response(nil)
+}
+
+// Shows the invitation a maybe link from the e-mail answers.
+// (GET /participants/{participantId}/maybe)
+func (api API) GetParticipantsParticipantIDMaybe(w http.ResponseWriter, r *http.Request, participantID string, params spec.GetParticipantsParticipantIDMaybeParams) *spec.Response {
+	preview, res := api.previewInvitation(r, participantID, params.Token, pgstore.RsvpStatusMaybe, errorResponses{
+		badRequest: spec.GetParticipantsParticipantIDMaybeJSON400Response,
+		notFound:   spec.GetParticipantsParticipantIDMaybeJSON404Response,
+		internal:   spec.GetParticipantsParticipantIDMaybeJSON500Response,
+	})
+	if res != nil {
+		return res
+	}
+
+	return spec.GetParticipantsParticipantIDMaybeJSON200Response(preview)
+}
+
+// Answers maybe to a trip invitation.
+// (PATCH /participants/{participantId}/maybe)
+func (api API) PatchParticipantsParticipantIDMaybe(w http.ResponseWriter, r *http.Request, participantID string, params spec.PatchParticipantsParticipantIDMaybeParams) *spec.Response {
+	var body spec.RsvpRequest
+	if res := api.decodeOptionalBody(w, r, &body, errorResponses{
+		badRequest:    spec.PatchParticipantsParticipantIDMaybeJSON400Response,
+		unprocessable: spec.PatchParticipantsParticipantIDMaybeJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	if res := api.respondToInvitation(r, participantID, params.Token, pgstore.RsvpStatusMaybe, spec.ConfirmParticipantRequest{Comment: body.Comment}, errorResponses{
+		badRequest: spec.PatchParticipantsParticipantIDMaybeJSON400Response,
+		notFound:   spec.PatchParticipantsParticipantIDMaybeJSON404Response,
+		conflict:   spec.PatchParticipantsParticipantIDMaybeJSON409Response,
+		internal:   spec.PatchParticipantsParticipantIDMaybeJSON500Response,
+	}); res != nil {
+		return res
+	}
+
+	return spec.PatchParticipantsParticipantIDMaybeJSON204Response(nil)
+}
+
+// previewInvitation backs the GET routes the invitation e-mail links to. Mail
+// scanners and link previews follow those links too, so they only describe
+// the invitation and leave the token unused; the answer is recorded by the
+// PATCH routes.
+func (api API) previewInvitation(r *http.Request, participantID string, token string, answer pgstore.RsvpStatus, res errorResponses) (spec.InvitationPreview, *spec.Response) {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.InvitationPreview{}, res.badRequest(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(token, tokens.PurposeParticipantConfirm, id); err != nil {
+		return spec.InvitationPreview{}, res.badRequest(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	// A token that was already used or revoked would fail once the answer is
+	// sent, so the link says so straight away.
+	stored, err := api.store.GetConfirmationToken(r.Context(), pgstore.GetConfirmationTokenParams{
+		TokenHash: tokens.Hash(token),
+		Purpose:   string(tokens.PurposeParticipantConfirm),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.InvitationPreview{}, res.badRequest(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		}
+		return spec.InvitationPreview{}, res.internal(api.internalError(r, "failed to get token", err, zap.String("participant_id", participantID)))
+	}
+
+	if !stored.ParticipantID.Valid || uuid.UUID(stored.ParticipantID.Bytes) != id {
+		return spec.InvitationPreview{}, res.badRequest(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.InvitationPreview{}, res.notFound(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+		}
+		return spec.InvitationPreview{}, res.internal(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.InvitationPreview{}, res.notFound(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.InvitationPreview{}, res.internal(api.internalError(r, "failed to get trip", err, zap.String("participant_id", participantID)))
+	}
+
+	loc := trip.Location()
+	return spec.InvitationPreview{
+		ParticipantID: participant.ID.String(),
+		TripID:        trip.ID.String(),
+		Destination:   trip.Destination,
+		StartsAt:      trip.StartsAt.Time.In(loc),
+		EndsAt:        trip.EndsAt.Time.In(loc),
+		Timezone:      trip.Timezone,
+		OwnerName:     trip.OwnerName,
+		RsvpStatus:    rsvpStatuses[participant.RsvpStatus],
+		Answer:        rsvpStatuses[answer],
+	}, nil
+}
+
+// respondToInvitation is shared by the PATCH routes that record an answer to
+// an invitation. It returns nil once the answer is recorded. Answering maybe
+// leaves the link working, so the participant can still accept or decline.
+func (api API) respondToInvitation(r *http.Request, participantID string, token string, status pgstore.RsvpStatus, response spec.ConfirmParticipantRequest, res errorResponses) *spec.Response {
 	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "invalid uuid",})
+		return res.badRequest(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(token, tokens.PurposeParticipantConfirm, id); err != nil {
+		return res.badRequest(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
 	}
 
 	participant, err := api.store.GetParticipant(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participant not found",})
+			return res.notFound(newError(r, spec.ErrorCodeNotFound, "participant not found"))
 		}
-		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again",})
+		return res.internal(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
 	}
 
-	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participant already confirmed",})
+	if participant.RsvpStatus == status {
+		return res.conflict(newError(r, spec.ErrorCodeAlreadyAnswered, fmt.Sprintf("participant already answered %q", status)))
 	}
 
-	if err := api.store.ConfirmParticipant(r.Context(), id); err != nil {
-		api.logger.Error("failed to confim participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again",})
+	if err := api.store.RespondToInvitationWithToken(r.Context(), api.pool, id, tokens.Hash(token), status, response); err != nil {
+		switch {
+		case errors.Is(err, pgstore.ErrInvalidConfirmationToken):
+			return res.badRequest(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		case errors.Is(err, pgstore.ErrTripClosed):
+			return res.conflict(newError(r, spec.ErrorCodeTripClosed, "trip can no longer be changed"))
+		}
+		return res.internal(api.internalError(r, "failed to record invitation answer", err, zap.String("participant_id", participantID)))
 	}
 
-	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+	return nil
 }
 
 // Create a new trip
 // (POST /trips)
 func (api API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
 	var body spec.CreateTripRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
-	if err != nil {
-		spec.PostTripsJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
-	}
-
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostTripsJSON400Response,
+		unprocessable: spec.PostTripsJSON422Response,
+	}); res != nil {
+		return res
 	}
 
 	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body)
 	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "Failed to create trip, try again"})
+		return spec.PostTripsJSON500Response(api.internalError(r, "failed to create trip", err))
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
-			api.logger.Error("failed to send email on SendConfirmTripEmailToTripOwner", zap.Error(err), zap.String("trip_id", tripID.String()))
-		}
-	} ()
-
 	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()});
 }
 
@@ -118,24 +329,59 @@ func (api API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response
 func (api API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.GetTripsTripIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
 	trip, err := api.store.GetTrip(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "trip not found",})
+			return spec.GetTripsTripIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.GetTripsTripIDJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	rsvp, err := api.store.CountTripRsvps(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDJSON500Response(api.internalError(r, "failed to count rsvps", err, zap.String("trip_id", tripID)))
+	}
+
+	transitions, err := api.store.GetTripStatusTransitions(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDJSON500Response(api.internalError(r, "failed to get trip status history", err, zap.String("trip_id", tripID)))
+	}
+
+	// Every time is rendered in the zone the trip happens in.
+	loc := trip.Location()
+
+	history := make([]spec.TripStatusTransition, len(transitions))
+	for i, transition := range transitions {
+		history[i] = spec.TripStatusTransition{
+			To: tripStatuses[transition.ToStatus],
+			At: transition.TransitionedAt.Time.In(loc),
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "something went wrong, try again",})
+		if transition.FromStatus.Valid {
+			from := string(transition.FromStatus.TripStatus)
+			history[i].From = &from
+		}
+	}
+
+	cancelledAt := optionalTime(trip.CancelledAt)
+	if cancelledAt != nil {
+		*cancelledAt = cancelledAt.In(loc)
 	}
 
 	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{Trip: spec.GetTripDetailsResponseTripObj{
-		Destination: trip.Destination,
-		EndsAt:      trip.EndsAt.Time,
-		ID:          trip.ID.String(),
-		IsConfirmed: trip.IsConfirmed,
-		StartsAt:    trip.StartsAt.Time,
+		Destination:        trip.Destination,
+		EndsAt:             trip.EndsAt.Time.In(loc),
+		ID:                 trip.ID.String(),
+		IsConfirmed:        trip.Status.InvitationsSent(),
+		Status:             tripStatuses[trip.Status],
+		StatusHistory:      history,
+		StartsAt:           trip.StartsAt.Time.In(loc),
+		Timezone:           trip.Timezone,
+		CancelledAt:        cancelledAt,
+		CancellationReason: optionalString(trip.CancellationReason),
+		Rsvp:               rsvpCounts(rsvp),
 	}});
 }
 
@@ -143,40 +389,162 @@ func (api API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID str
 // (PUT /trips/{tripId})
 func (api API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	var body spec.UpdateTripRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PutTripsTripIDJSON400Response,
+		unprocessable: spec.PutTripsTripIDJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PutTripsTripIDJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	if !trip.Status.Open() {
+		return spec.PutTripsTripIDJSON409Response(newError(r, spec.ErrorCodeTripClosed, fmt.Sprintf("trip is %s, it can no longer be changed", trip.Status)))
+	}
+
+	if err := api.store.PutTrip(r.Context(), api.pool, body, id); err != nil {
+		if errors.Is(err, pgstore.ErrTripClosed) {
+			return spec.PutTripsTripIDJSON409Response(newError(r, spec.ErrorCodeTripClosed, "trip can no longer be changed"))
+		}
+		var orphaned *pgstore.OrphanedActivitiesError
+		if errors.As(err, &orphaned) {
+			// Point at every activity that has to move or go before the
+			// trip can be shortened.
+			loc := trip.Location()
+			details := make([]spec.ErrorDetail, len(orphaned.Activities))
+			for i, activity := range orphaned.Activities {
+				details[i] = activityOutsideTrip(fmt.Sprintf("activities[%s].occurs_at", activity.ID), body.StartsAt.In(loc), body.EndsAt.In(loc))
+			}
+			e := newError(r, spec.ErrorCodeValidationFailed, fmt.Sprintf("the new dates would leave %d activities outside of the trip", len(details)))
+			e.Details = details
+			return spec.PutTripsTripIDJSON422Response(e)
+		}
+		return spec.PutTripsTripIDJSON500Response(api.internalError(r, "failed to update trip", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.PutTripsTripIDJSON204Response(nil);
+}
+
+// Delete a trip that was never confirmed.
+// (DELETE /trips/{tripId})
+func (api API) DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+		return spec.DeleteTripsTripIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.DeleteTripsTripIDJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	if trip.Status.InvitationsSent() {
+		return spec.DeleteTripsTripIDJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, "confirmed trips can't be deleted, cancel the trip instead"))
+	}
+
+	// The rows go with the trip, their files are removed once it is gone.
+	attachments, err := api.store.GetTripAttachments(r.Context(), pgstore.GetTripAttachmentsParams{TripID: id})
+	if err != nil {
+		return spec.DeleteTripsTripIDJSON500Response(api.internalError(r, "failed to get attachments", err, zap.String("trip_id", tripID)))
+	}
+
+	if err := api.store.DeleteTrip(r.Context(), api.pool, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.DeleteTripsTripIDJSON500Response(api.internalError(r, "failed to delete trip", err, zap.String("trip_id", tripID)))
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	keys := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		keys[i] = attachment.StorageKey
+	}
+	api.deleteFiles(r, keys...)
+
+	return spec.DeleteTripsTripIDJSON204Response(nil)
+}
+
+// Cancel a confirmed trip and notify its participants.
+// (POST /trips/{tripId}/cancel)
+func (api API) PostTripsTripIDCancel(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	// The body is optional, it only carries the reason shared with participants.
+	var body spec.CancelTripRequest
+	if res := api.decodeOptionalBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostTripsTripIDCancelJSON400Response,
+		unprocessable: spec.PostTripsTripIDCancelJSON422Response,
+	}); res != nil {
+		return res
 	}
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.PostTripsTripIDCancelJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "trip not found",})
+			return spec.PostTripsTripIDCancelJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "something went wrong, try again",})
+		return spec.PostTripsTripIDCancelJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
 	}
 
-	if err := api.store.PutTrip(r.Context(), api.pool, body, id); err != nil {
-		api.logger.Error("failed to update trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "something went wrong, try again",})
+	if !trip.Status.InvitationsSent() {
+		return spec.PostTripsTripIDCancelJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, "trip is not confirmed, delete the draft instead"))
+	}
+
+	if !pgstore.CanTransition(trip.Status, pgstore.TripStatusCancelled) {
+		return spec.PostTripsTripIDCancelJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, fmt.Sprintf("trip is %s, it can't be cancelled", trip.Status)))
 	}
 
-	go func() {
-		if err := api.mailer.ReSendConfirmTripEmailToTripOwner(id); err != nil {
-			api.logger.Error("failed to send email on ReSendConfirmTripEmailToTripOwner", zap.Error(err), zap.String("trip_id", id.String()))
+	if err := api.store.CancelTrip(r.Context(), api.pool, body, id); err != nil {
+		var invalid *pgstore.InvalidTransitionError
+		if errors.As(err, &invalid) {
+			return spec.PostTripsTripIDCancelJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, fmt.Sprintf("trip is %s, it can't be cancelled", invalid.From)))
 		}
-	} ()
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDCancelJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PostTripsTripIDCancelJSON500Response(api.internalError(r, "failed to cancel trip", err, zap.String("trip_id", tripID)))
+	}
 
-	return spec.PutTripsTripIDJSON204Response(nil);
+	return spec.PostTripsTripIDCancelJSON204Response(nil)
+}
+
+// Archive a completed or cancelled trip.
+// (POST /trips/{tripId}/archive)
+func (api API) PostTripsTripIDArchive(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDArchiveJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.store.TransitionTrip(r.Context(), id, pgstore.TripStatusArchived); err != nil {
+		var invalid *pgstore.InvalidTransitionError
+		if errors.As(err, &invalid) {
+			return spec.PostTripsTripIDArchiveJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, fmt.Sprintf("trip is %s, only completed or cancelled trips can be archived", invalid.From)))
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDArchiveJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PostTripsTripIDArchiveJSON500Response(api.internalError(r, "failed to archive trip", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.PostTripsTripIDArchiveJSON204Response(nil)
 }
 
 // Get a trip activities.
@@ -184,150 +552,414 @@ func (api API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID str
 func (api API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.GetTripsTripIDActivitiesJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.GetTripsTripIDActivitiesJSON500Response(api.internalError(r, "failed to get trip by id", err, zap.String("trip_id", tripID)))
 	}
 
 	activities, err := api.store.GetTripActivities(r.Context(), id)
-    if err != nil {
-        if errors.Is(err, pgx.ErrNoRows) {
-            return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "activities not found"})
-        }
-        api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
-        return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
-    }
-
-	// Group activities by date
-    activityMap := make(map[time.Time][]spec.GetTripActivitiesResponseInnerArray)
-    for _, activity := range activities {
-        if !activity.OccursAt.Valid {
-            api.logger.Error("invalid timestamp", zap.String("activity_id", activity.ID.String()))
-            return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "failed to process activities"})
-        }
-
-        occursAt := activity.OccursAt.Time
-        date := time.Date(occursAt.Year(), occursAt.Month(), occursAt.Day(), 0, 0, 0, 0, occursAt.Location())
-        innerActivity := spec.GetTripActivitiesResponseInnerArray{
-            ID:       activity.ID.String(),
-            Title:    activity.Title,
-            OccursAt: occursAt,
-        }
-        activityMap[date] = append(activityMap[date], innerActivity)
-    }
-
-	var arrActivities spec.GetTripActivitiesResponse
-    for date, innerActivities := range activityMap {
-        outerActivity := spec.GetTripActivitiesResponseOuterArray{
-            Date:       date,
-            Activities: innerActivities,
-        }
-        arrActivities.Activities = append(arrActivities.Activities, outerActivity)
-    }
-
-	return spec.GetTripsTripIDActivitiesJSON200Response(arrActivities)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON500Response(api.internalError(r, "failed to get activities", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{Activities: buildItinerary(trip, activities)})
 }
 
 // Create a trip activity.
 // (POST /trips/{tripId}/activities)
 func (api API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	var body spec.CreateActivityRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostTripsTripIDActivitiesJSON400Response,
+		unprocessable: spec.PostTripsTripIDActivitiesJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	id, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+		return spec.PostTripsTripIDActivitiesJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	activityID, err := api.store.InsertActivity(r.Context(), api.pool, body, id)
+	if err != nil {
+		var outside *pgstore.ActivityOutsideTripError
+		if errors.As(err, &outside) {
+			e := newError(r, spec.ErrorCodeValidationFailed, "invalid input")
+			e.Details = []spec.ErrorDetail{activityOutsideTrip("occurs_at", outside.StartsAt, outside.EndsAt)}
+			return spec.PostTripsTripIDActivitiesJSON422Response(e)
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDActivitiesJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PostTripsTripIDActivitiesJSON500Response(api.internalError(r, "failed to create activity", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: activityID.String()});
+}
+
+// Replace a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId})
+func (api API) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	var body spec.UpdateActivityRequest
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PutTripsTripIDActivitiesActivityIDJSON400Response,
+		unprocessable: spec.PutTripsTripIDActivitiesActivityIDJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	if res := api.patchActivity(r, tripID, activityID, spec.PatchActivityRequest{
+		OccursAt: &body.OccursAt,
+		Title:    &body.Title,
+	}, errorResponses{
+		badRequest:    spec.PutTripsTripIDActivitiesActivityIDJSON400Response,
+		notFound:      spec.PutTripsTripIDActivitiesActivityIDJSON404Response,
+		unprocessable: spec.PutTripsTripIDActivitiesActivityIDJSON422Response,
+		internal:      spec.PutTripsTripIDActivitiesActivityIDJSON500Response,
+	}); res != nil {
+		return res
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	return spec.PutTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Update some fields of a trip activity.
+// (PATCH /trips/{tripId}/activities/{activityId})
+func (api API) PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	var body spec.PatchActivityRequest
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PatchTripsTripIDActivitiesActivityIDJSON400Response,
+		unprocessable: spec.PatchTripsTripIDActivitiesActivityIDJSON422Response,
+	}); res != nil {
+		return res
 	}
 
+	if res := api.patchActivity(r, tripID, activityID, body, errorResponses{
+		badRequest:    spec.PatchTripsTripIDActivitiesActivityIDJSON400Response,
+		notFound:      spec.PatchTripsTripIDActivitiesActivityIDJSON404Response,
+		unprocessable: spec.PatchTripsTripIDActivitiesActivityIDJSON422Response,
+		internal:      spec.PatchTripsTripIDActivitiesActivityIDJSON500Response,
+	}); res != nil {
+		return res
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// patchActivity is shared by the PUT and PATCH activity routes, a PUT being a
+// PATCH that sets every field. It returns nil once the activity is updated.
+func (api API) patchActivity(r *http.Request, tripID string, activityID string, body spec.PatchActivityRequest, res errorResponses) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid uuid",})
+		return res.badRequest(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	activityID, err := api.store.InsertActivity(r.Context(), api.pool, body, id)
+	activity, err := uuid.Parse(activityID)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Failed to create activity, try again"})
+		return res.badRequest(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: activityID.String()});
+	if err := api.store.PatchActivity(r.Context(), api.pool, body, id, activity); err != nil {
+		var outside *pgstore.ActivityOutsideTripError
+		if errors.As(err, &outside) {
+			e := newError(r, spec.ErrorCodeValidationFailed, "invalid input")
+			e.Details = []spec.ErrorDetail{activityOutsideTrip("occurs_at", outside.StartsAt, outside.EndsAt)}
+			return res.unprocessable(e)
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return res.notFound(newError(r, spec.ErrorCodeNotFound, "activity not found"))
+		}
+		return res.internal(api.internalError(r, "failed to update activity", err, zap.String("trip_id", tripID), zap.String("activity_id", activityID)))
+	}
+
+	return nil
 }
 
-// Confirm a trip and send e-mail invitations.
+// Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api API) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	activity, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	n, err := api.store.DeleteActivity(r.Context(), pgstore.DeleteActivityParams{ID: activity, TripID: id})
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON500Response(api.internalError(r, "failed to delete activity", err, zap.String("trip_id", tripID), zap.String("activity_id", activityID)))
+	}
+	if n == 0 {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "activity not found"))
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Shows the trip a confirmation link from the e-mail confirms.
 // (GET /trips/{tripId}/confirm)
-func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDConfirmParams) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.GetTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(params.Token, tokens.PurposeTripConfirm, id); err != nil {
+		return spec.GetTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
 	}
 
 	trip, err := api.store.GetTrip(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "trip not found",})
+			return spec.GetTripsTripIDConfirmJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.GetTripsTripIDConfirmJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	if !pgstore.CanTransition(trip.Status, pgstore.TripStatusConfirmed) {
+		return spec.GetTripsTripIDConfirmJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, "trip already confirmed"))
+	}
+
+	// A token that was already used or revoked would fail once the trip is
+	// confirmed, so the link says so straight away.
+	stored, err := api.store.GetConfirmationToken(r.Context(), pgstore.GetConfirmationTokenParams{
+		TokenHash: tokens.Hash(params.Token),
+		Purpose:   string(tokens.PurposeTripConfirm),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again",})
+		return spec.GetTripsTripIDConfirmJSON500Response(api.internalError(r, "failed to get token", err, zap.String("trip_id", tripID)))
+	}
+
+	if stored.TripID != id {
+		return spec.GetTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	loc := trip.Location()
+	return spec.GetTripsTripIDConfirmJSON200Response(spec.TripConfirmationPreview{
+		TripID:      trip.ID.String(),
+		Destination: trip.Destination,
+		StartsAt:    trip.StartsAt.Time.In(loc),
+		EndsAt:      trip.EndsAt.Time.In(loc),
+		Timezone:    trip.Timezone,
+		OwnerName:   trip.OwnerName,
+		Status:      tripStatuses[trip.Status],
+	})
+}
+
+// Confirm a trip and send e-mail invitations.
+// (PATCH /trips/{tripId}/confirm)
+func (api API) PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDConfirmParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(params.Token, tokens.PurposeTripConfirm, id); err != nil {
+		return spec.PatchTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
 	}
 
-	if trip.IsConfirmed {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "trip already confirmed",})
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchTripsTripIDConfirmJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PatchTripsTripIDConfirmJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
 	}
 
-	if err := api.store.ConfirmTrip(r.Context(), id); err != nil {
-		api.logger.Error("failed to confim trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again",})
+	if !pgstore.CanTransition(trip.Status, pgstore.TripStatusConfirmed) {
+		return spec.PatchTripsTripIDConfirmJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, "trip already confirmed"))
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripinvitations(id); err != nil {
-			api.logger.Error("failed to send email on SendConfirmTripEmailToTripinvitations", zap.Error(err), zap.String("trip_id", id.String()))
+	if err := api.store.ConfirmTripWithToken(r.Context(), api.pool, id, tokens.Hash(params.Token)); err != nil {
+		if errors.Is(err, pgstore.ErrInvalidConfirmationToken) {
+			return spec.PatchTripsTripIDConfirmJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		}
+		var invalid *pgstore.InvalidTransitionError
+		if errors.As(err, &invalid) {
+			return spec.PatchTripsTripIDConfirmJSON409Response(newError(r, spec.ErrorCodeInvalidTransition, "trip already confirmed"))
 		}
-	} ()
+		return spec.PatchTripsTripIDConfirmJSON500Response(api.internalError(r, "failed to confim trip", err, zap.String("trip_id", tripID)))
+	}
 
-	return spec.GetTripsTripIDConfirmJSON204Response(nil)
+	return spec.PatchTripsTripIDConfirmJSON204Response(nil)
 }
 
 // Invite someone to the trip.
 // (POST /trips/{tripId}/invites)
 func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	var body spec.InviteParticipantRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
-	if err != nil {
-		spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostTripsTripIDInvitesJSON400Response,
+		unprocessable: spec.PostTripsTripIDInvitesJSON422Response,
+	}); res != nil {
+		return res
 	}
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.PostTripsTripIDInvitesJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	participantID, err := api.store.InsertInviteParticipantToTrip(r.Context(), api.pool, body, id)
+	if err != nil {
+		var duplicate *pgstore.DuplicateParticipantError
+		if errors.As(err, &duplicate) {
+			return spec.PostTripsTripIDInvitesJSON409Response(spec.DuplicateParticipantError{
+				Code:          spec.ErrorCodeDuplicateParticipant,
+				Message:       "e-mail already invited to this trip",
+				ParticipantID: duplicate.ParticipantID.String(),
+				RequestID:     requestID(r),
+			})
+		}
+		if errors.Is(err, pgstore.ErrTripClosed) {
+			return spec.PostTripsTripIDInvitesJSON409Response(newError(r, spec.ErrorCodeTripClosed, "trip can no longer be changed"))
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDInvitesJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PostTripsTripIDInvitesJSON500Response(api.internalError(r, "failed to invite participant", err, zap.String("trip_id", tripID)))
 	}
 
-	participantID, err := api.store.InsertInviteParticipantToTrip(r.Context(), api.pool, body, id)
+	return spec.PostTripsTripIDInvitesJSON201Response(spec.InviteParticipantResponse{ParticipantID: participantID.String()});
+}
+
+// Invite many people to the trip at once.
+// (POST /trips/{tripId}/invites/bulk)
+func (api API) PostTripsTripIDInvitesBulk(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Failed to insert invite participant, try again"})
+		return spec.PostTripsTripIDInvitesBulkJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
 	trip, err := api.store.GetTrip(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "trip not found",})
+			return spec.PostTripsTripIDInvitesBulkJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PostTripsTripIDInvitesBulkJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	if !trip.Status.Open() {
+		return spec.PostTripsTripIDInvitesBulkJSON409Response(newError(r, spec.ErrorCodeTripClosed, fmt.Sprintf("trip is %s, it can no longer be changed", trip.Status)))
+	}
+
+	rows, err := readBulkInvites(w, r)
+	if err != nil {
+		return spec.PostTripsTripIDInvitesBulkJSON400Response(decodeError(r, err))
+	}
+
+	if len(rows) > maxBulkInvites {
+		return spec.PostTripsTripIDInvitesBulkJSON422Response(newError(r, spec.ErrorCodeValidationFailed, fmt.Sprintf("invalid input: at most %d invites per request", maxBulkInvites)))
+	}
+
+	var response spec.BulkInviteResponse
+	response.Results = make([]spec.BulkInviteResult, len(rows))
+
+	// Only valid rows reach the store, validRows remembers where they came from.
+	var valid []spec.BulkInviteRow
+	var validRows []int
+	for i, row := range rows {
+		response.Results[i] = spec.BulkInviteResult{Row: i + 1, Email: row.Email}
+
+		reason := ""
+		if err := api.validator.Struct(row); err != nil {
+			reason = detailsSummary(validationError(r, err))
+		} else if strings.EqualFold(row.Email, trip.OwnerEmail) {
+			reason = "the trip owner can't be invited"
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "something went wrong, try again",})
+
+		if reason != "" {
+			response.Results[i].Status = spec.BulkInviteResultStatusInvalid
+			response.Results[i].Error = &reason
+			response.Invalid++
+			continue
+		}
+
+		valid = append(valid, row)
+		validRows = append(validRows, i)
 	}
 
-	if (trip.IsConfirmed) {
-		go func() {
-			if err := api.mailer.SendConfirmTripEmailToTripinvitation(id,participantID); err != nil {
-				api.logger.Error("failed to send email on SendConfirmTripEmailToTripinvitation", zap.Error(err), zap.String("trip_id", id.String()))
+	if len(valid) > 0 {
+		outcomes, err := api.store.BulkInviteParticipantsToTrip(r.Context(), api.pool, valid, id)
+		if err != nil {
+			if errors.Is(err, pgstore.ErrTripClosed) {
+				return spec.PostTripsTripIDInvitesBulkJSON409Response(newError(r, spec.ErrorCodeTripClosed, "trip can no longer be changed"))
+			}
+			if errors.Is(err, pgx.ErrNoRows) {
+				return spec.PostTripsTripIDInvitesBulkJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
 			}
-		} ()
+			return spec.PostTripsTripIDInvitesBulkJSON500Response(api.internalError(r, "failed to bulk invite participants", err, zap.String("trip_id", tripID)))
+		}
+
+		for i, outcome := range outcomes {
+			result := &response.Results[validRows[i]]
+			participantID := outcome.ParticipantID.String()
+			result.ParticipantID = &participantID
+
+			if outcome.Duplicate {
+				result.Status = spec.BulkInviteResultStatusDuplicate
+				response.Duplicates++
+			} else {
+				result.Status = spec.BulkInviteResultStatusCreated
+				response.Created++
+			}
+		}
 	}
-	
-	return spec.PostTripsTripIDInvitesJSON201Response(spec.InviteParticipantResponse{ParticipantID: participantID.String()});
+
+	return spec.PostTripsTripIDInvitesBulkJSON200Response(response)
+}
+
+// Revoke a pending invitation.
+// (DELETE /trips/{tripId}/invites/{participantId})
+func (api API) DeleteTripsTripIDInvitesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params spec.DeleteTripsTripIDInvitesParticipantIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDInvitesParticipantIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	pid, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDInvitesParticipantIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), pid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDInvitesParticipantIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "invitation not found"))
+		}
+		return spec.DeleteTripsTripIDInvitesParticipantIDJSON500Response(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
+	}
+
+	if participant.TripID != id {
+		return spec.DeleteTripsTripIDInvitesParticipantIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "invitation not found"))
+	}
+
+	if participant.RsvpStatus != pgstore.RsvpStatusPending {
+		return spec.DeleteTripsTripIDInvitesParticipantIDJSON409Response(newError(r, spec.ErrorCodeAlreadyAnswered, "invitation already answered, remove the participant instead"))
+	}
+
+	notify := params.Notify != nil && *params.Notify
+	if err := api.store.RevokeInvitation(r.Context(), api.pool, id, pid, notify); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDInvitesParticipantIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "invitation not found"))
+		}
+		return spec.DeleteTripsTripIDInvitesParticipantIDJSON500Response(api.internalError(r, "failed to revoke invitation", err, zap.String("participant_id", participantID)))
+	}
+
+	return spec.DeleteTripsTripIDInvitesParticipantIDJSON204Response(nil)
 }
 
 // Get a trip links.
@@ -335,16 +967,19 @@ func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tr
 func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.GetTripsTripIDLinksJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	links, err := api.store.GetTripLinks(r.Context(), id)
-	if err != nil {
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "participants not found",})
+			return spec.GetTripsTripIDLinksJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
 		}
-		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "something went wrong, try again",})
+		return spec.GetTripsTripIDLinksJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	links, err := api.store.GetTripLinks(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDLinksJSON500Response(api.internalError(r, "failed to get links", err, zap.String("trip_id", tripID)))
 	}
 
 	arrLinks := make([]spec.GetLinksResponseArray, len(links))
@@ -353,6 +988,24 @@ func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripI
 			ID:          link.ID.String(),
 			Title:       link.Title,
 			URL:         link.Url,
+			Description: optionalString(link.Description),
+			SortOrder:   link.SortOrder,
+			AddedBy:     optionalEmail(link.AddedBy),
+			CreatedAt:   link.CreatedAt.Time,
+			UpdatedAt:   link.UpdatedAt.Time,
+		}
+		if link.Category.Valid {
+			category := linkCategories[link.Category.LinkCategory]
+			arrLinks[i].Category = &category
+		}
+		arrLinks[i].PreviewStatus = linkPreviewStatuses[link.PreviewStatus]
+		if link.PreviewStatus == pgstore.LinkPreviewStatusReady {
+			arrLinks[i].Preview = &spec.LinkPreview{
+				Title:       optionalString(link.PreviewTitle),
+				Description: optionalString(link.PreviewDescription),
+				ImageURL:    optionalString(link.PreviewImageUrl),
+				SiteName:    optionalString(link.PreviewSiteName),
+			}
 		}
 	}
 
@@ -365,58 +1018,279 @@ func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripI
 // (POST /trips/{tripId}/links)
 func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	var body spec.CreateLinkRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
-	if err != nil {
-		spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostTripsTripIDLinksJSON400Response,
+		unprocessable: spec.PostTripsTripIDLinksJSON422Response,
+	}); res != nil {
+		return res
 	}
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.PostTripsTripIDLinksJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDLinksJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.PostTripsTripIDLinksJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
 	}
 
-	linkId, err := api.store.InsertTripsTripIDLinks(r.Context(), api.pool, body, id)
+	// The member policy only lets signed in callers through.
+	caller, _ := auth.UserFrom(r.Context())
+	linkId, err := api.store.InsertTripsTripIDLinks(r.Context(), body, id, caller.Email)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "Failed to insert trip link, try again"})
+		return spec.PostTripsTripIDLinksJSON500Response(api.internalError(r, "failed to insert trip link", err, zap.String("trip_id", tripID)))
 	}
 	
 	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{LinkID: linkId.String()});
 }
 
+// Replace a trip link.
+// (PUT /trips/{tripId}/links/{linkId})
+func (api API) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	var body spec.UpdateLinkRequest
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PutTripsTripIDLinksLinkIDJSON400Response,
+		unprocessable: spec.PutTripsTripIDLinksLinkIDJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	link, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.store.ReplaceTripLink(r.Context(), body, id, link); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDLinksLinkIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "link not found"))
+		}
+		return spec.PutTripsTripIDLinksLinkIDJSON500Response(api.internalError(r, "failed to update link", err, zap.String("trip_id", tripID), zap.String("link_id", linkID)))
+	}
+
+	return spec.PutTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// Delete a trip link.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (api API) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	link, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	n, err := api.store.DeleteTripLink(r.Context(), pgstore.DeleteTripLinkParams{ID: link, TripID: id})
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON500Response(api.internalError(r, "failed to delete link", err, zap.String("trip_id", tripID), zap.String("link_id", linkID)))
+	}
+	if n == 0 {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "link not found"))
+	}
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
 // Get a trip participants.
 // (GET /trips/{tripId}/participants)
 func (api API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid uuid",})
+		return spec.GetTripsTripIDParticipantsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
 	}
 
-	participants, err := api.store.GetParticipants(r.Context(), id)
-	if err != nil {
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "participants not found",})
+			return spec.GetTripsTripIDParticipantsJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
 		}
-		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "something went wrong, try again",})
+		return spec.GetTripsTripIDParticipantsJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsJSON500Response(api.internalError(r, "failed to get participants", err, zap.String("trip_id", tripID)))
+	}
+
+	rsvp, err := api.store.CountTripRsvps(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsJSON500Response(api.internalError(r, "failed to count rsvps", err, zap.String("trip_id", tripID)))
 	}
-	
 
 	arrParticipants := make([]spec.GetTripParticipantsResponseArray, len(participants))
 	for i, participant := range participants {
 		arrParticipants[i] = spec.GetTripParticipantsResponseArray{
 			Email:       types.Email(participant.Email),
 			ID:          participant.ID.String(),
-			IsConfirmed: participant.IsConfirmed,
-			Name:        nil,
+			IsConfirmed: participant.RsvpStatus == pgstore.RsvpStatusAccepted,
+			Name:        optionalString(participant.Name),
+			Phone:       optionalString(participant.Phone),
+			Notes:       optionalString(participant.Notes),
+			RsvpStatus:  rsvpStatuses[participant.RsvpStatus],
+			RespondedAt: optionalTime(participant.RespondedAt),
+			RsvpComment: optionalString(participant.RsvpComment),
+			Role:        participantRoleValues[participant.Role],
 		}
 	}
 
 	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
 		Participants: arrParticipants,
+		Rsvp:         rsvpCounts(rsvp),
 	})
 }
 
+// Remove a participant from a trip.
+// (DELETE /trips/{tripId}/participants/{participantId})
+func (api API) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params spec.DeleteTripsTripIDParticipantsParticipantIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	pid, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), pid)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON500Response(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
+	}
+	if err != nil || participant.TripID != id {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+	}
+
+	// Co-organisers can't remove each other, like they can't revoke each
+	// other's role.
+	if roleFrom(r.Context()) < roleOwner && participant.Role == pgstore.ParticipantRoleCoOrganiser {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON403Response(newError(r, spec.ErrorCodeForbidden, "only the trip owner can remove a co-organiser"))
+	}
+
+	notify := params.Notify != nil && *params.Notify
+	if err := api.store.RemoveParticipant(r.Context(), api.pool, id, pid, notify); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+		}
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON500Response(api.internalError(r, "failed to remove participant", err, zap.String("participant_id", participantID)))
+	}
+
+	return spec.DeleteTripsTripIDParticipantsParticipantIDJSON204Response(nil)
+}
+
+// Change the role of a participant on a trip.
+// (PUT /trips/{tripId}/participants/{participantId}/role)
+func (api API) PutTripsTripIDParticipantsParticipantIDRole(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	var body spec.UpdateParticipantRoleRequest
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PutTripsTripIDParticipantsParticipantIDRoleJSON400Response,
+		unprocessable: spec.PutTripsTripIDParticipantsParticipantIDRoleJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	pid, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), pid)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON500Response(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
+	}
+	if err != nil || participant.TripID != id {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+	}
+
+	to := pgstore.ParticipantRole(body.Role.ToValue())
+	// Co-organisers can hand out every role but their own, which only the
+	// owner grants and takes back.
+	if roleFrom(r.Context()) < roleOwner && (to == pgstore.ParticipantRoleCoOrganiser || participant.Role == pgstore.ParticipantRoleCoOrganiser) {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON403Response(newError(r, spec.ErrorCodeForbidden, "only the trip owner can grant or revoke the co-organiser role"))
+	}
+
+	n, err := api.store.UpdateParticipantRole(r.Context(), pgstore.UpdateParticipantRoleParams{Role: to, ID: pid, TripID: id})
+	if err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON500Response(api.internalError(r, "failed to update participant role", err, zap.String("participant_id", participantID)))
+	}
+	if n == 0 {
+		return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+	}
+
+	return spec.PutTripsTripIDParticipantsParticipantIDRoleJSON204Response(nil)
+}
+
+var tripStatuses = map[pgstore.TripStatus]spec.TripStatus{
+	pgstore.TripStatusDraft:               spec.TripStatusDraft,
+	pgstore.TripStatusPendingConfirmation: spec.TripStatusPendingConfirmation,
+	pgstore.TripStatusConfirmed:           spec.TripStatusConfirmed,
+	pgstore.TripStatusInProgress:          spec.TripStatusInProgress,
+	pgstore.TripStatusCompleted:           spec.TripStatusCompleted,
+	pgstore.TripStatusCancelled:           spec.TripStatusCancelled,
+	pgstore.TripStatusArchived:            spec.TripStatusArchived,
+}
+
+var rsvpStatuses = map[pgstore.RsvpStatus]spec.RsvpStatus{
+	pgstore.RsvpStatusPending:  spec.RsvpStatusPending,
+	pgstore.RsvpStatusAccepted: spec.RsvpStatusAccepted,
+	pgstore.RsvpStatusDeclined: spec.RsvpStatusDeclined,
+	pgstore.RsvpStatusMaybe:    spec.RsvpStatusMaybe,
+}
+
+var linkCategories = map[pgstore.LinkCategory]spec.LinkCategory{
+	pgstore.LinkCategoryBooking:  spec.LinkCategoryBooking,
+	pgstore.LinkCategoryDocument: spec.LinkCategoryDocument,
+	pgstore.LinkCategoryMap:      spec.LinkCategoryMap,
+	pgstore.LinkCategoryTicket:   spec.LinkCategoryTicket,
+}
+
+var linkPreviewStatuses = map[pgstore.LinkPreviewStatus]spec.LinkPreviewStatus{
+	pgstore.LinkPreviewStatusPending: spec.LinkPreviewStatusPending,
+	pgstore.LinkPreviewStatusReady:   spec.LinkPreviewStatusReady,
+	pgstore.LinkPreviewStatusFailed:  spec.LinkPreviewStatusFailed,
+}
+
+func rsvpCounts(row pgstore.CountTripRsvpsRow) spec.RsvpCounts {
+	return spec.RsvpCounts{
+		Pending:  int(row.Pending),
+		Accepted: int(row.Accepted),
+		Declined: int(row.Declined),
+		Maybe:    int(row.Maybe),
+	}
+}
+
+func optionalString(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+func optionalTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func optionalEmail(t pgtype.Text) *types.Email {
+	if !t.Valid {
+		return nil
+	}
+	email := types.Email(t.String)
+	return &email
+}
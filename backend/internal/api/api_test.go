@@ -0,0 +1,553 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/oidc"
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/tokens"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// fakeStore answers from canned rows. The embedded interface is left nil, a
+// test calling a method it did not expect panics.
+type fakeStore struct {
+	store
+
+	trips        map[uuid.UUID]pgstore.Trip
+	participants map[uuid.UUID]pgstore.Participant
+	tokens       map[string]pgstore.GetConfirmationTokenRow
+	// identities holds the users accounts at a provider sign in, keyed by
+	// issuer and subject.
+	identities map[[2]string]pgstore.User
+	// logins holds the unused sign-in links, keyed by login ID and token hash.
+	logins map[string]pgstore.GetLoginTokenRow
+	// transfers holds the pending ownership transfers, keyed by trip.
+	transfers map[uuid.UUID]pgstore.OwnershipTransfer
+
+	responses []pgstore.RsvpStatus
+	accepted  []uuid.UUID
+	listed    []pgstore.ListUserTripsParams
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		trips:        map[uuid.UUID]pgstore.Trip{},
+		participants: map[uuid.UUID]pgstore.Participant{},
+		tokens:       map[string]pgstore.GetConfirmationTokenRow{},
+		identities:   map[[2]string]pgstore.User{},
+		transfers:    map[uuid.UUID]pgstore.OwnershipTransfer{},
+		logins:       map[string]pgstore.GetLoginTokenRow{},
+	}
+}
+
+func (s *fakeStore) GetTrip(_ context.Context, tripID uuid.UUID) (pgstore.Trip, error) {
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return trip, nil
+}
+
+// ListUserTrips lists every trip, in the order and from the cursor asked for.
+// Filters are left to the query.
+func (s *fakeStore) ListUserTrips(_ context.Context, arg pgstore.ListUserTripsParams) ([]pgstore.Trip, error) {
+	s.listed = append(s.listed, arg)
+
+	trips := make([]pgstore.Trip, 0, len(s.trips))
+	for _, trip := range s.trips {
+		trips = append(trips, trip)
+	}
+	before := func(a, b pgstore.Trip) bool {
+		if !a.StartsAt.Time.Equal(b.StartsAt.Time) {
+			return a.StartsAt.Time.Before(b.StartsAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	}
+	sort.Slice(trips, func(i, j int) bool {
+		return before(trips[i], trips[j]) != arg.Descending
+	})
+
+	var page []pgstore.Trip
+	for _, trip := range trips {
+		if arg.AfterStartsAt.Valid {
+			cursor := pgstore.Trip{ID: arg.AfterID.Bytes, StartsAt: arg.AfterStartsAt}
+			if after := before(cursor, trip); after == arg.Descending || trip.ID == cursor.ID {
+				continue
+			}
+		}
+		if len(page) < int(arg.RowLimit) {
+			page = append(page, trip)
+		}
+	}
+	return page, nil
+}
+
+func (s *fakeStore) ConfirmTripWithToken(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, tokenHash []byte) error {
+	key := string(tokenHash) + string(tokens.PurposeTripConfirm)
+	if token, ok := s.tokens[key]; !ok || token.TripID != tripID {
+		return pgstore.ErrInvalidConfirmationToken
+	}
+	delete(s.tokens, key)
+
+	trip := s.trips[tripID]
+	trip.Status = pgstore.TripStatusConfirmed
+	s.trips[tripID] = trip
+	return nil
+}
+
+// InsertInviteParticipantToTrip follows the store: closed trips take no one
+// and an e-mail is invited once per trip.
+func (s *fakeStore) InsertInviteParticipantToTrip(_ context.Context, _ *pgxpool.Pool, params spec.InviteParticipantRequest, tripID uuid.UUID) (uuid.UUID, error) {
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return uuid.UUID{}, pgx.ErrNoRows
+	}
+	if !trip.Status.Open() {
+		return uuid.UUID{}, pgstore.ErrTripClosed
+	}
+	for _, participant := range s.participants {
+		if participant.TripID == tripID && strings.EqualFold(participant.Email, string(params.Email)) {
+			return uuid.UUID{}, &pgstore.DuplicateParticipantError{ParticipantID: participant.ID}
+		}
+	}
+
+	participant := pgstore.Participant{ID: uuid.New(), TripID: tripID, Email: string(params.Email), RsvpStatus: pgstore.RsvpStatusPending}
+	s.participants[participant.ID] = participant
+	return participant.ID, nil
+}
+
+func (s *fakeStore) GetParticipant(_ context.Context, participantID uuid.UUID) (pgstore.Participant, error) {
+	participant, ok := s.participants[participantID]
+	if !ok {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+	return participant, nil
+}
+
+// GetTripParticipantRole follows the query: declined participants hold no
+// role and the one linked to the account wins over one matching by e-mail.
+func (s *fakeStore) GetTripParticipantRole(_ context.Context, arg pgstore.GetTripParticipantRoleParams) (pgstore.GetTripParticipantRoleRow, error) {
+	var match *pgstore.Participant
+	for _, participant := range s.participants {
+		participant := participant
+		if participant.TripID != arg.TripID || participant.RsvpStatus == pgstore.RsvpStatusDeclined {
+			continue
+		}
+		if participant.UserID.Valid && participant.UserID == arg.UserID {
+			match = &participant
+			break
+		}
+		if strings.EqualFold(participant.Email, arg.Email) {
+			match = &participant
+		}
+	}
+	if match == nil {
+		return pgstore.GetTripParticipantRoleRow{}, pgx.ErrNoRows
+	}
+	return pgstore.GetTripParticipantRoleRow{Role: match.Role, RsvpStatus: match.RsvpStatus}, nil
+}
+
+func (s *fakeStore) RemoveParticipant(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID, _ bool) error {
+	participant, ok := s.participants[participantID]
+	if !ok || participant.TripID != tripID {
+		return pgx.ErrNoRows
+	}
+	delete(s.participants, participantID)
+	return nil
+}
+
+func (s *fakeStore) GetConfirmationToken(_ context.Context, arg pgstore.GetConfirmationTokenParams) (pgstore.GetConfirmationTokenRow, error) {
+	row, ok := s.tokens[string(arg.TokenHash)+arg.Purpose]
+	if !ok {
+		return pgstore.GetConfirmationTokenRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+// RespondToInvitationWithToken follows the store: maybe leaves the token
+// valid, accepting or declining uses it up.
+func (s *fakeStore) RespondToInvitationWithToken(_ context.Context, _ *pgxpool.Pool, participantID uuid.UUID, tokenHash []byte, status pgstore.RsvpStatus, _ spec.ConfirmParticipantRequest) error {
+	key := string(tokenHash) + string(tokens.PurposeParticipantConfirm)
+	token, ok := s.tokens[key]
+	if !ok || uuid.UUID(token.ParticipantID.Bytes) != participantID {
+		return pgstore.ErrInvalidConfirmationToken
+	}
+	if !s.trips[token.TripID].Status.Open() {
+		return pgstore.ErrTripClosed
+	}
+	if status != pgstore.RsvpStatusMaybe {
+		delete(s.tokens, key)
+	}
+
+	participant := s.participants[participantID]
+	participant.RsvpStatus = status
+	s.participants[participantID] = participant
+	s.responses = append(s.responses, status)
+	return nil
+}
+
+func (s *fakeStore) GetPendingOwnershipTransfer(_ context.Context, tripID uuid.UUID) (pgstore.OwnershipTransfer, error) {
+	transfer, ok := s.transfers[tripID]
+	if !ok {
+		return pgstore.OwnershipTransfer{}, pgx.ErrNoRows
+	}
+	return transfer, nil
+}
+
+func (s *fakeStore) AcceptOwnershipWithToken(_ context.Context, _ *pgxpool.Pool, participantID uuid.UUID, _ []byte) error {
+	s.accepted = append(s.accepted, participantID)
+	return nil
+}
+
+func (s *fakeStore) GetLoginToken(_ context.Context, arg pgstore.GetLoginTokenParams) (pgstore.GetLoginTokenRow, error) {
+	login, ok := s.logins[arg.ID.String()+string(arg.TokenHash)]
+	if !ok {
+		return pgstore.GetLoginTokenRow{}, pgx.ErrNoRows
+	}
+	return login, nil
+}
+
+func (s *fakeStore) SignInWithToken(_ context.Context, _ *pgxpool.Pool, loginID uuid.UUID, tokenHash []byte) (pgstore.User, error) {
+	key := loginID.String() + string(tokenHash)
+	login, ok := s.logins[key]
+	if !ok {
+		return pgstore.User{}, pgstore.ErrInvalidLoginToken
+	}
+	delete(s.logins, key)
+	return pgstore.User{ID: uuid.New(), Email: login.Email}, nil
+}
+
+// SignInWithIdentity follows the store: the e-mail of an account seen for the
+// first time must be verified, a known account signs in as its user.
+func (s *fakeStore) SignInWithIdentity(_ context.Context, _ *pgxpool.Pool, identity pgstore.Identity) (pgstore.User, error) {
+	key := [2]string{identity.Issuer, identity.Subject}
+	if user, ok := s.identities[key]; ok {
+		return user, nil
+	}
+	if !identity.EmailVerified || identity.Email == "" {
+		return pgstore.User{}, pgstore.ErrUnverifiedEmail
+	}
+	user := pgstore.User{ID: uuid.New(), Email: identity.Email}
+	s.identities[key] = user
+	return user, nil
+}
+
+// testServer serves the generated router over fakeStore the way main wires
+// it, policies included.
+type testServer struct {
+	*httptest.Server
+	store    *fakeStore
+	signer   tokens.Signer
+	sessions auth.Sessions
+}
+
+func newTestServer(t *testing.T, fs *fakeStore) *testServer {
+	t.Helper()
+
+	return newTestServerWithProvider(t, fs, nil)
+}
+
+// newTestServerWithProvider is newTestServer with sign-in through provider.
+func newTestServerWithProvider(t *testing.T, fs *fakeStore, provider *oidc.Provider) *testServer {
+	t.Helper()
+
+	signer := tokens.NewSigner([]byte("test token secret"), time.Hour)
+	sessions := auth.NewSessions([]byte("test session secret"), time.Hour)
+	si := API{store: fs, logger: zap.NewNop(), validator: newValidator(), signer: signer, sessions: sessions, provider: provider}
+
+	r := chi.NewMux()
+	r.Use(Authenticate(sessions))
+	r.Mount("/", spec.Handler(&si, spec.WithErrorHandler(ParamErrorHandler), spec.WithMiddlewares(si.Policies())))
+
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return &testServer{srv, fs, signer, sessions}
+}
+
+// do sends a request signed in as user, anonymously when user is nil.
+func (s *testServer) do(t *testing.T, method string, path string, user *auth.User) *http.Response {
+	t.Helper()
+
+	return s.send(t, method, path, user, nil)
+}
+
+// send is do with body encoded as JSON, none when body is nil.
+func (s *testServer) send(t *testing.T, method string, path string, user *auth.User, body any) *http.Response {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, s.URL+path, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if user != nil {
+		session, err := s.sessions.Issue(*user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+session.Token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = res.Body.Close() })
+	return res
+}
+
+// issueToken signs a token and stores its hash like the mailer does.
+func (s *testServer) issueToken(t *testing.T, purpose tokens.Purpose, tripID uuid.UUID, participantID uuid.UUID) string {
+	t.Helper()
+
+	token, err := s.signer.Issue(purpose, participantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.store.tokens[string(token.Hash)+string(purpose)] = pgstore.GetConfirmationTokenRow{
+		TripID:        tripID,
+		ParticipantID: pgtype.UUID{Bytes: participantID, Valid: true},
+	}
+	return token.Value
+}
+
+func readJSON(t *testing.T, res *http.Response, v any) {
+	t.Helper()
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInvitationLinksRecordNothing(t *testing.T) {
+	fs := newFakeStore()
+	trip := pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Lisbon",
+		OwnerName:   "Grace",
+		OwnerEmail:  "grace@example.com",
+		Timezone:    "Europe/Lisbon",
+		StartsAt:    pgtype.Timestamptz{Time: time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC), Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: time.Date(2025, 3, 20, 18, 0, 0, 0, time.UTC), Valid: true},
+		Status:      pgstore.TripStatusConfirmed,
+	}
+	fs.trips[trip.ID] = trip
+	participant := pgstore.Participant{ID: uuid.New(), TripID: trip.ID, Email: "ada@example.com", RsvpStatus: pgstore.RsvpStatusPending}
+	fs.participants[participant.ID] = participant
+
+	srv := newTestServer(t, fs)
+	token := srv.issueToken(t, tokens.PurposeParticipantConfirm, trip.ID, participant.ID)
+
+	for link, answer := range map[string]spec.RsvpStatus{
+		"confirm": spec.RsvpStatusAccepted,
+		"decline": spec.RsvpStatusDeclined,
+		"maybe":   spec.RsvpStatusMaybe,
+	} {
+		t.Run(link, func(t *testing.T) {
+			res := srv.do(t, http.MethodGet, "/participants/"+participant.ID.String()+"/"+link+"?token="+token, nil)
+			if res.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusOK)
+			}
+
+			var preview spec.InvitationPreview
+			readJSON(t, res, &preview)
+			if preview.Answer != answer || preview.RsvpStatus != spec.RsvpStatusPending || preview.Destination != trip.Destination {
+				t.Errorf("preview = %+v", preview)
+			}
+		})
+	}
+
+	if len(fs.responses) != 0 {
+		t.Fatalf("following the links recorded %v", fs.responses)
+	}
+
+	res := srv.do(t, http.MethodPatch, "/participants/"+participant.ID.String()+"/maybe?token="+token, nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("PATCH status = %d, want %d", res.StatusCode, http.StatusNoContent)
+	}
+	if len(fs.responses) != 1 || fs.responses[0] != pgstore.RsvpStatusMaybe {
+		t.Fatalf("responses = %v, want [maybe]", fs.responses)
+	}
+}
+
+func TestInvitationLinkRejectsUsedToken(t *testing.T) {
+	fs := newFakeStore()
+	tripID := uuid.New()
+	participant := pgstore.Participant{ID: uuid.New(), TripID: tripID, Email: "ada@example.com"}
+	fs.participants[participant.ID] = participant
+
+	srv := newTestServer(t, fs)
+	token, err := srv.signer.Issue(tokens.PurposeParticipantConfirm, participant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Signed correctly but no longer stored as unused.
+	res := srv.do(t, http.MethodGet, "/participants/"+participant.ID.String()+"/confirm?token="+token.Value, nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestChangeAnswerFromMaybe(t *testing.T) {
+	fs := newFakeStore()
+	trip := pgstore.Trip{ID: uuid.New(), OwnerEmail: "grace@example.com", Status: pgstore.TripStatusConfirmed}
+	fs.trips[trip.ID] = trip
+	participant := pgstore.Participant{ID: uuid.New(), TripID: trip.ID, Email: "ada@example.com", RsvpStatus: pgstore.RsvpStatusPending}
+	fs.participants[participant.ID] = participant
+
+	srv := newTestServer(t, fs)
+	token := srv.issueToken(t, tokens.PurposeParticipantConfirm, trip.ID, participant.ID)
+	answer := func(link string) int {
+		return srv.do(t, http.MethodPatch, "/participants/"+participant.ID.String()+"/"+link+"?token="+token, nil).StatusCode
+	}
+
+	if status := answer("maybe"); status != http.StatusNoContent {
+		t.Fatalf("maybe: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if status := answer("confirm"); status != http.StatusNoContent {
+		t.Fatalf("confirm after maybe: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if got := fs.participants[participant.ID].RsvpStatus; got != pgstore.RsvpStatusAccepted {
+		t.Fatalf("rsvp status = %s, want accepted", got)
+	}
+	// Accepting settled it, the link is used up.
+	if status := answer("decline"); status != http.StatusBadRequest {
+		t.Fatalf("decline after confirm: status = %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestAnswerToClosedTrip(t *testing.T) {
+	for _, status := range []pgstore.TripStatus{pgstore.TripStatusCancelled, pgstore.TripStatusArchived} {
+		fs := newFakeStore()
+		trip := pgstore.Trip{ID: uuid.New(), OwnerEmail: "grace@example.com", Status: status}
+		fs.trips[trip.ID] = trip
+		participant := pgstore.Participant{ID: uuid.New(), TripID: trip.ID, Email: "ada@example.com", RsvpStatus: pgstore.RsvpStatusPending}
+		fs.participants[participant.ID] = participant
+
+		srv := newTestServer(t, fs)
+		token := srv.issueToken(t, tokens.PurposeParticipantConfirm, trip.ID, participant.ID)
+		res := srv.do(t, http.MethodPatch, "/participants/"+participant.ID.String()+"/confirm?token="+token, nil)
+		if res.StatusCode != http.StatusConflict {
+			t.Errorf("%s trip: status = %d, want %d", status, res.StatusCode, http.StatusConflict)
+			continue
+		}
+
+		var body spec.Error
+		readJSON(t, res, &body)
+		if body.Code != spec.ErrorCodeTripClosed {
+			t.Errorf("%s trip: code = %v, want trip_closed", status, body.Code)
+		}
+	}
+}
+
+func TestTripConfirmationLink(t *testing.T) {
+	fs := newFakeStore()
+	trip := pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Lisbon",
+		OwnerName:   "Grace",
+		OwnerEmail:  "grace@example.com",
+		Timezone:    "Europe/Lisbon",
+		StartsAt:    pgtype.Timestamptz{Time: time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC), Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: time.Date(2025, 3, 20, 18, 0, 0, 0, time.UTC), Valid: true},
+		Status:      pgstore.TripStatusPendingConfirmation,
+	}
+	fs.trips[trip.ID] = trip
+
+	srv := newTestServer(t, fs)
+	token, err := srv.signer.Issue(tokens.PurposeTripConfirm, trip.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.tokens[string(token.Hash)+string(tokens.PurposeTripConfirm)] = pgstore.GetConfirmationTokenRow{TripID: trip.ID}
+	path := "/trips/" + trip.ID.String() + "/confirm?token=" + token.Value
+
+	res := srv.do(t, http.MethodGet, path, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("GET status = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	var preview spec.TripConfirmationPreview
+	readJSON(t, res, &preview)
+	if preview.Destination != trip.Destination || preview.Status != spec.TripStatusPendingConfirmation {
+		t.Errorf("preview = %+v", preview)
+	}
+	if fs.trips[trip.ID].Status != pgstore.TripStatusPendingConfirmation {
+		t.Fatalf("following the link confirmed the trip")
+	}
+
+	if res := srv.do(t, http.MethodPatch, path, nil); res.StatusCode != http.StatusNoContent {
+		t.Fatalf("PATCH status = %d, want %d", res.StatusCode, http.StatusNoContent)
+	}
+	if fs.trips[trip.ID].Status != pgstore.TripStatusConfirmed {
+		t.Fatalf("status = %s, want confirmed", fs.trips[trip.ID].Status)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPatch} {
+		if res := srv.do(t, method, path, nil); res.StatusCode != http.StatusConflict {
+			t.Errorf("%s once confirmed: status = %d, want %d", method, res.StatusCode, http.StatusConflict)
+		}
+	}
+}
+
+func TestInviteConflicts(t *testing.T) {
+	owner := auth.User{ID: uuid.New(), Email: "grace@example.com"}
+	setup := func(status pgstore.TripStatus) (*testServer, pgstore.Trip, pgstore.Participant) {
+		fs := newFakeStore()
+		trip := pgstore.Trip{ID: uuid.New(), OwnerEmail: owner.Email, OwnerID: pgtype.UUID{Bytes: owner.ID, Valid: true}, Status: status}
+		fs.trips[trip.ID] = trip
+		participant := pgstore.Participant{ID: uuid.New(), TripID: trip.ID, Email: "ada@example.com", RsvpStatus: pgstore.RsvpStatusPending}
+		fs.participants[participant.ID] = participant
+		return newTestServer(t, fs), trip, participant
+	}
+
+	srv, trip, participant := setup(pgstore.TripStatusConfirmed)
+	res := srv.send(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", &owner, spec.InviteParticipantRequest{Email: "ADA@example.com"})
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("duplicate: status = %d, want %d", res.StatusCode, http.StatusConflict)
+	}
+	var duplicate spec.DuplicateParticipantError
+	readJSON(t, res, &duplicate)
+	if duplicate.Code != spec.ErrorCodeDuplicateParticipant || duplicate.ParticipantID != participant.ID.String() {
+		t.Errorf("duplicate: body = %+v", duplicate)
+	}
+
+	srv, trip, _ = setup(pgstore.TripStatusCancelled)
+	res = srv.send(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", &owner, spec.InviteParticipantRequest{Email: "alan@example.com"})
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("closed trip: status = %d, want %d", res.StatusCode, http.StatusConflict)
+	}
+	var body map[string]any
+	readJSON(t, res, &body)
+	if _, ok := body["participantId"]; ok || body["code"] != spec.ErrorCodeTripClosed.ToValue() {
+		t.Errorf("closed trip: body = %v, want a plain trip_closed error", body)
+	}
+}
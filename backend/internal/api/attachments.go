@@ -0,0 +1,373 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/storage"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+const (
+	maxAttachmentBytes = 10 << 20
+	// maxAttachmentFormBytes leaves room for the other fields and the
+	// multipart framing around the file.
+	maxAttachmentFormBytes = maxAttachmentBytes + 1<<20
+	// attachmentMemoryBytes is how much of an upload is kept in memory, the
+	// rest is spooled to a temporary file.
+	attachmentMemoryBytes = 1 << 20
+	// attachmentTransferTimeout replaces the server timeouts, which are meant
+	// for JSON bodies, while a file is sent either way.
+	attachmentTransferTimeout = 2 * time.Minute
+	maxAttachmentNameLength   = 255
+)
+
+// attachmentTypes are the types of file that can be attached to a trip, as
+// detected from their content.
+var attachmentTypes = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"image/heic",
+	"image/heif",
+}
+
+// attachmentForm holds the fields uploaded next to the file.
+type attachmentForm struct {
+	ActivityID string `json:"activity_id" validate:"omitempty,uuid"`
+}
+
+// Get the files attached to a trip.
+// (GET /trips/{tripId}/attachments)
+func (api API) GetTripsTripIDAttachments(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDAttachmentsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDAttachmentsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	var activityID pgtype.UUID
+	if params.ActivityID != nil {
+		activity, err := uuid.Parse(*params.ActivityID)
+		if err != nil {
+			return spec.GetTripsTripIDAttachmentsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+		}
+		activityID = pgtype.UUID{Bytes: activity, Valid: true}
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDAttachmentsJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.GetTripsTripIDAttachmentsJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("trip_id", tripID)))
+	}
+
+	attachments, err := api.store.GetTripAttachments(r.Context(), pgstore.GetTripAttachmentsParams{TripID: id, ActivityID: activityID})
+	if err != nil {
+		return spec.GetTripsTripIDAttachmentsJSON500Response(api.internalError(r, "failed to get attachments", err, zap.String("trip_id", tripID)))
+	}
+
+	arrAttachments := make([]spec.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		arrAttachments[i] = spec.Attachment{
+			ID:          attachment.ID.String(),
+			ActivityID:  optionalUUID(attachment.ActivityID),
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+			UploadedBy:  types.Email(attachment.UploadedBy),
+			CreatedAt:   attachment.CreatedAt.Time,
+		}
+	}
+
+	return spec.GetTripsTripIDAttachmentsJSON200Response(spec.GetAttachmentsResponse{
+		Attachments: arrAttachments,
+	})
+}
+
+// Attach a file to a trip.
+// (POST /trips/{tripId}/attachments)
+func (api API) PostTripsTripIDAttachments(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDAttachmentsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	_ = http.NewResponseController(w).SetReadDeadline(time.Now().Add(attachmentTransferTimeout))
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentFormBytes)
+	if err := r.ParseMultipartForm(attachmentMemoryBytes); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return spec.PostTripsTripIDAttachmentsJSON422Response(fileError(r, "max_size", fmt.Sprintf("must be at most %d MiB", maxAttachmentBytes>>20)))
+		}
+		return spec.PostTripsTripIDAttachmentsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid upload: "+err.Error()))
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	form := attachmentForm{
+		ActivityID: r.FormValue("activity_id"),
+	}
+	if err := api.validator.Struct(form); err != nil {
+		return spec.PostTripsTripIDAttachmentsJSON422Response(validationError(r, err))
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) {
+			return spec.PostTripsTripIDAttachmentsJSON422Response(fileError(r, "required", "is required"))
+		}
+		return spec.PostTripsTripIDAttachmentsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid upload: "+err.Error()))
+	}
+	defer file.Close()
+
+	if header.Size == 0 {
+		return spec.PostTripsTripIDAttachmentsJSON422Response(fileError(r, "required", "must not be empty"))
+	}
+	if header.Size > maxAttachmentBytes {
+		return spec.PostTripsTripIDAttachmentsJSON422Response(fileError(r, "max_size", fmt.Sprintf("must be at most %d MiB", maxAttachmentBytes>>20)))
+	}
+
+	contentType, err := detectAttachmentType(file)
+	if err != nil {
+		return spec.PostTripsTripIDAttachmentsJSON500Response(api.internalError(r, "failed to read upload", err, zap.String("trip_id", tripID)))
+	}
+	if contentType == "" {
+		return spec.PostTripsTripIDAttachmentsJSON422Response(fileError(r, "mime_type", "must be a PDF, or a JPEG, PNG, WebP or HEIC image"))
+	}
+
+	var activityID pgtype.UUID
+	if form.ActivityID != "" {
+		activity := uuid.MustParse(form.ActivityID)
+		if _, err := api.store.GetTripActivity(r.Context(), pgstore.GetTripActivityParams{ID: activity, TripID: id}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return spec.PostTripsTripIDAttachmentsJSON404Response(newError(r, spec.ErrorCodeNotFound, "activity not found"))
+			}
+			return spec.PostTripsTripIDAttachmentsJSON500Response(api.internalError(r, "failed to get activity", err, zap.String("trip_id", tripID)))
+		}
+		activityID = pgtype.UUID{Bytes: activity, Valid: true}
+	}
+
+	// The member policy let the request through, there is a caller.
+	caller, _ := auth.UserFrom(r.Context())
+
+	// The file is stored first, a row never points at a missing object.
+	attachmentID := uuid.New()
+	key := attachmentKey(id, attachmentID)
+	if err := api.files.Put(r.Context(), key, file, header.Size, contentType); err != nil {
+		return spec.PostTripsTripIDAttachmentsJSON500Response(api.internalError(r, "failed to store attachment", err, zap.String("trip_id", tripID)))
+	}
+
+	if err := api.store.CreateAttachment(r.Context(), pgstore.CreateAttachmentParams{
+		ID:          attachmentID,
+		TripID:      id,
+		ActivityID:  activityID,
+		FileName:    attachmentName(header.Filename, contentType),
+		ContentType: contentType,
+		SizeBytes:   header.Size,
+		StorageKey:  key,
+		UploadedBy:  caller.Email,
+	}); err != nil {
+		api.deleteFiles(r, key)
+		return spec.PostTripsTripIDAttachmentsJSON500Response(api.internalError(r, "failed to insert attachment", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.PostTripsTripIDAttachmentsJSON201Response(spec.CreateAttachmentResponse{AttachmentID: attachmentID.String()})
+}
+
+// Download a file attached to a trip.
+// (GET /trips/{tripId}/attachments/{attachmentId})
+func (api API) GetTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *spec.Response {
+	attachment, res := api.tripAttachment(r, tripID, attachmentID, errorResponses{
+		badRequest: spec.GetTripsTripIDAttachmentsAttachmentIDJSON400Response,
+		notFound:   spec.GetTripsTripIDAttachmentsAttachmentIDJSON404Response,
+		internal:   spec.GetTripsTripIDAttachmentsAttachmentIDJSON500Response,
+	})
+	if res != nil {
+		return res
+	}
+
+	body, err := api.files.Get(r.Context(), attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			api.logger.Error("attachment is missing from the storage", zap.String("attachment_id", attachmentID), zap.String("storage_key", attachment.StorageKey))
+			return spec.GetTripsTripIDAttachmentsAttachmentIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "attachment not found"))
+		}
+		return spec.GetTripsTripIDAttachmentsAttachmentIDJSON500Response(api.internalError(r, "failed to open attachment", err, zap.String("attachment_id", attachmentID)))
+	}
+	defer body.Close()
+
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(attachmentTransferTimeout))
+
+	h := w.Header()
+	h.Set("Content-Type", attachment.ContentType)
+	h.Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+	h.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	// Browsers must not guess their way into running an upload as a page,
+	// nor keep passport scans around.
+	h.Set("X-Content-Type-Options", "nosniff")
+	h.Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, body); err != nil {
+		api.logger.Warn("failed to send attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+	}
+
+	// The response is already written.
+	return nil
+}
+
+// Delete a file attached to a trip.
+// (DELETE /trips/{tripId}/attachments/{attachmentId})
+func (api API) DeleteTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *spec.Response {
+	attachment, res := api.tripAttachment(r, tripID, attachmentID, errorResponses{
+		badRequest: spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON400Response,
+		notFound:   spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON404Response,
+		internal:   spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON500Response,
+	})
+	if res != nil {
+		return res
+	}
+
+	// Members clean up after themselves, organisers after everyone.
+	caller, _ := auth.UserFrom(r.Context())
+	if roleFrom(r.Context()) < roleCoOrganiser && !strings.EqualFold(attachment.UploadedBy, caller.Email) {
+		return spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON403Response(newError(r, spec.ErrorCodeForbidden, "only the uploader and the trip organisers can delete a file"))
+	}
+
+	n, err := api.store.DeleteTripAttachment(r.Context(), pgstore.DeleteTripAttachmentParams{ID: attachment.ID, TripID: attachment.TripID})
+	if err != nil {
+		return spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON500Response(api.internalError(r, "failed to delete attachment", err, zap.String("attachment_id", attachmentID)))
+	}
+	if n == 0 {
+		return spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON404Response(newError(r, spec.ErrorCodeNotFound, "attachment not found"))
+	}
+
+	api.deleteFiles(r, attachment.StorageKey)
+
+	return spec.DeleteTripsTripIDAttachmentsAttachmentIDJSON204Response(nil)
+}
+
+// tripAttachment looks an attachment of a trip up. The policy of the
+// operation has already checked the caller belongs to the trip, so outsiders
+// can't tell which attachments exist.
+func (api API) tripAttachment(r *http.Request, tripID string, attachmentID string, res errorResponses) (pgstore.Attachment, *spec.Response) {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return pgstore.Attachment{}, res.badRequest(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	attachment, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return pgstore.Attachment{}, res.badRequest(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	found, err := api.store.GetTripAttachment(r.Context(), pgstore.GetTripAttachmentParams{ID: attachment, TripID: id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Attachment{}, res.notFound(newError(r, spec.ErrorCodeNotFound, "attachment not found"))
+		}
+		return pgstore.Attachment{}, res.internal(api.internalError(r, "failed to get attachment", err, zap.String("attachment_id", attachmentID)))
+	}
+
+	return found, nil
+}
+
+// deleteFiles removes stored files whose rows are gone. A failure only leaves
+// an unreachable object behind, so it is logged rather than reported.
+func (api API) deleteFiles(r *http.Request, keys ...string) {
+	for _, key := range keys {
+		if err := api.files.Delete(r.Context(), key); err != nil {
+			api.logger.Warn("failed to delete attachment from the storage", zap.Error(err), zap.String("storage_key", key))
+		}
+	}
+}
+
+func attachmentKey(tripID uuid.UUID, attachmentID uuid.UUID) string {
+	return "trips/" + tripID.String() + "/attachments/" + attachmentID.String()
+}
+
+// detectAttachmentType sniffs the type of file from its first bytes, then
+// rewinds it. It returns "" for types that can't be attached.
+func detectAttachmentType(file multipart.File) (string, error) {
+	detected, err := mimetype.DetectReader(file)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	for _, allowed := range attachmentTypes {
+		if detected.Is(allowed) {
+			return allowed, nil
+		}
+	}
+	return "", nil
+}
+
+// attachmentName keeps the base name of what the client called the file,
+// without directories or control characters, cut to fit the column.
+func attachmentName(name string, contentType string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+
+	if name == "" || name == "." || name == ".." {
+		name = "attachment"
+		if detected := mimetype.Lookup(contentType); detected != nil {
+			name += detected.Extension()
+		}
+	}
+
+	if runes := []rune(name); len(runes) > maxAttachmentNameLength {
+		ext := []rune(filepath.Ext(name))
+		if len(ext) > 16 {
+			ext = nil
+		}
+		name = string(runes[:maxAttachmentNameLength-len(ext)]) + string(ext)
+	}
+
+	return name
+}
+
+// fileError reports a problem with the uploaded file the way validation
+// errors are reported.
+func fileError(r *http.Request, rule string, message string) spec.Error {
+	e := newError(r, spec.ErrorCodeValidationFailed, "invalid input")
+	e.Details = []spec.ErrorDetail{{Field: "file", Rule: rule, Message: message}}
+	return e
+}
+
+func optionalUUID(u pgtype.UUID) *string {
+	if !u.Valid {
+		return nil
+	}
+	id := uuid.UUID(u.Bytes).String()
+	return &id
+}
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/tokens"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// sessionCookie carries the session token of browsers that signed in by
+// following the e-mailed link.
+const sessionCookie = "swallowgo_session"
+
+// Authenticate puts the user a request is signed in as on its context, from a
+// bearer token or the session cookie. Requests without either go through
+// anonymously, it is up to the handlers to require a user. A token that is
+// present but invalid or expired is answered with 401.
+func Authenticate(sessions auth.Sessions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := sessionToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			user, err := sessions.Verify(token)
+			if err != nil {
+				writeError(w, http.StatusUnauthorized, newError(r, spec.ErrorCodeUnauthorized, "invalid or expired session, sign in again"))
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithUser(r.Context(), user)))
+		})
+	}
+}
+
+func sessionToken(r *http.Request) (string, bool) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, token, _ := strings.Cut(header, " ")
+		return strings.TrimSpace(token), strings.EqualFold(scheme, "Bearer")
+	}
+
+	if cookie, err := r.Cookie(sessionCookie); err == nil && cookie.Value != "" {
+		return cookie.Value, true
+	}
+
+	return "", false
+}
+
+// Send a sign-in link by e-mail.
+// (POST /auth/login)
+func (api API) PostAuthLogin(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.LoginRequest
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostAuthLoginJSON400Response,
+		unprocessable: spec.PostAuthLoginJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	if err := api.store.RequestMagicLink(r.Context(), string(body.Email)); err != nil {
+		return spec.PostAuthLoginJSON500Response(api.internalError(r, "failed to request magic link", err))
+	}
+
+	return spec.PostAuthLoginJSON202Response(nil)
+}
+
+// Shows the sign-in a link from the e-mail is for.
+// (GET /auth/login/{loginId})
+func (api API) GetAuthLoginLoginID(w http.ResponseWriter, r *http.Request, loginID string, params spec.GetAuthLoginLoginIDParams) *spec.Response {
+	id, err := uuid.Parse(loginID)
+	if err != nil {
+		return spec.GetAuthLoginLoginIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(params.Token, tokens.PurposeLogin, id); err != nil {
+		return spec.GetAuthLoginLoginIDJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	login, err := api.store.GetLoginToken(r.Context(), pgstore.GetLoginTokenParams{ID: id, TokenHash: tokens.Hash(params.Token)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetAuthLoginLoginIDJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		}
+		return spec.GetAuthLoginLoginIDJSON500Response(api.internalError(r, "failed to get login token", err, zap.String("login_id", loginID)))
+	}
+
+	return spec.GetAuthLoginLoginIDJSON200Response(spec.LoginPreview{
+		Email:     types.Email(login.Email),
+		ExpiresAt: login.ExpiresAt.Time,
+	})
+}
+
+// Sign in with the link sent by e-mail.
+// (POST /auth/login/{loginId})
+func (api API) PostAuthLoginLoginID(w http.ResponseWriter, r *http.Request, loginID string, params spec.PostAuthLoginLoginIDParams) *spec.Response {
+	id, err := uuid.Parse(loginID)
+	if err != nil {
+		return spec.PostAuthLoginLoginIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(params.Token, tokens.PurposeLogin, id); err != nil {
+		return spec.PostAuthLoginLoginIDJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	user, err := api.store.SignInWithToken(r.Context(), api.pool, id, tokens.Hash(params.Token))
+	if err != nil {
+		if errors.Is(err, pgstore.ErrInvalidLoginToken) {
+			return spec.PostAuthLoginLoginIDJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		}
+		return spec.PostAuthLoginLoginIDJSON500Response(api.internalError(r, "failed to sign in", err, zap.String("login_id", loginID)))
+	}
+
+	session, err := api.startSession(w, r, user)
+	if err != nil {
+		return spec.PostAuthLoginLoginIDJSON500Response(api.internalError(r, "failed to issue session", err, zap.String("user_id", user.ID.String())))
+	}
+
+	return spec.PostAuthLoginLoginIDJSON200Response(session)
+}
+
+// Sign out of the session cookie.
+// (POST /auth/logout)
+func (api API) PostAuthLogout(w http.ResponseWriter, r *http.Request) *spec.Response {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Path:     "/",
+		Expires:  time.Unix(0, 0),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secureRequest(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return spec.PostAuthLogoutJSON204Response(nil)
+}
+
+// Get the signed in user.
+// (GET /auth/me)
+func (api API) GetAuthMe(w http.ResponseWriter, r *http.Request) *spec.Response {
+	caller, ok := auth.UserFrom(r.Context())
+	if !ok {
+		return spec.GetAuthMeJSON401Response(newError(r, spec.ErrorCodeUnauthorized, "sign in first"))
+	}
+
+	user, err := api.store.GetUser(r.Context(), caller.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetAuthMeJSON401Response(newError(r, spec.ErrorCodeUnauthorized, "invalid or expired session, sign in again"))
+		}
+		return spec.GetAuthMeJSON500Response(api.internalError(r, "failed to get user", err, zap.String("user_id", caller.ID.String())))
+	}
+
+	return spec.GetAuthMeJSON200Response(userResponse(user))
+}
+
+// startSession issues a session for user and sets its cookie.
+func (api API) startSession(w http.ResponseWriter, r *http.Request, user pgstore.User) (spec.SessionResponse, error) {
+	session, err := api.sessions.Issue(auth.User{ID: user.ID, Email: user.Email})
+	if err != nil {
+		return spec.SessionResponse{}, err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    session.Token,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   secureRequest(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return spec.SessionResponse{
+		Token:     session.Token,
+		ExpiresAt: session.ExpiresAt,
+		User:      userResponse(user),
+	}, nil
+}
+
+func userResponse(user pgstore.User) spec.User {
+	return spec.User{
+		ID:        user.ID.String(),
+		Email:     types.Email(user.Email),
+		CreatedAt: user.CreatedAt.Time,
+	}
+}
+
+// secureRequest reports whether r reached us over https, directly or through
+// a proxy terminating TLS.
+func secureRequest(r *http.Request) bool {
+	return r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https")
+}
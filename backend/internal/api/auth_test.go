@@ -0,0 +1,62 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/tokens"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestMagicLink(t *testing.T) {
+	fs := newFakeStore()
+	srv := newTestServer(t, fs)
+
+	loginID := uuid.New()
+	token, err := srv.signer.WithTTL(tokens.LoginTTL).Issue(tokens.PurposeLogin, loginID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.logins[loginID.String()+string(token.Hash)] = pgstore.GetLoginTokenRow{
+		Email:     "ada@example.com",
+		ExpiresAt: pgtype.Timestamptz{Time: token.ExpiresAt, Valid: true},
+	}
+	path := "/auth/login/" + loginID.String() + "?token=" + token.Value
+
+	// Following the link, as a mail scanner does, neither uses it up nor
+	// signs anyone in.
+	for i := 0; i < 2; i++ {
+		res := srv.do(t, http.MethodGet, path, nil)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("GET status = %d, want %d", res.StatusCode, http.StatusOK)
+		}
+		if len(res.Cookies()) != 0 {
+			t.Fatalf("GET set cookies %v", res.Cookies())
+		}
+
+		var preview spec.LoginPreview
+		readJSON(t, res, &preview)
+		if preview.Email != "ada@example.com" {
+			t.Errorf("preview = %+v", preview)
+		}
+	}
+
+	res := srv.do(t, http.MethodPost, path, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("POST status = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	var session spec.SessionResponse
+	readJSON(t, res, &session)
+	if _, err := srv.sessions.Verify(session.Token); err != nil || session.User.Email != "ada@example.com" {
+		t.Fatalf("session = %+v, verify: %v", session, err)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		if res := srv.do(t, method, path, nil); res.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s of a used link: status = %d, want %d", method, res.StatusCode, http.StatusBadRequest)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// maxBodyBytes bounds every JSON request body.
+const maxBodyBytes = 1 << 20
+
+// errEmptyBody is returned by decodeJSON when the request has no body.
+var errEmptyBody = errors.New("request body is required")
+
+// decodeBody decodes the JSON body of r into dst and validates it, answering
+// with the operation's 400 response when the body is malformed and with its
+// 422 response when it is well-formed but invalid.
+func (api API) decodeBody(w http.ResponseWriter, r *http.Request, dst any, res errorResponses) *spec.Response {
+	if err := decodeJSON(w, r, dst); err != nil {
+		return res.badRequest(decodeError(r, err))
+	}
+
+	if err := api.validator.Struct(dst); err != nil {
+		return res.unprocessable(validationError(r, err))
+	}
+
+	return nil
+}
+
+// decodeOptionalBody is decodeBody for operations whose body may be left out,
+// in which case dst keeps its zero value.
+func (api API) decodeOptionalBody(w http.ResponseWriter, r *http.Request, dst any, res errorResponses) *spec.Response {
+	if err := decodeJSON(w, r, dst); err != nil && !errors.Is(err, errEmptyBody) {
+		return res.badRequest(decodeError(r, err))
+	}
+
+	if err := api.validator.Struct(dst); err != nil {
+		return res.unprocessable(validationError(r, err))
+	}
+
+	return nil
+}
+
+// decodeJSON reads a single JSON value from the body of r into dst. The body
+// must be sent as application/json, fit in maxBodyBytes and only carry fields
+// that dst knows about.
+func decodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	if r.ContentLength == 0 {
+		return errEmptyBody
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return errors.New("missing Content-Type header, expected application/json")
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("invalid Content-Type header: %w", err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %q, expected application/json", mediaType)
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	return decodeStrict(r.Body, dst)
+}
+
+// decodeStrict decodes exactly one JSON value from body, rejecting fields
+// that dst does not declare.
+func decodeStrict(body io.Reader, dst any) error {
+	dec := json.NewDecoder(body)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errEmptyBody
+		}
+		return err
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("request body must contain a single JSON value")
+	}
+
+	return nil
+}
+
+// decodeError describes what is wrong with a body decodeJSON rejected,
+// pointing at the offending field when the decoder knows it.
+func decodeError(r *http.Request, err error) spec.Error {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+		maxErr    *http.MaxBytesError
+		timeErr   *time.ParseError
+		detail    *spec.ErrorDetail
+		message   string
+	)
+
+	switch {
+	case errors.As(err, &syntaxErr):
+		message = fmt.Sprintf("malformed JSON at byte %d: %s", syntaxErr.Offset, syntaxErr.Error())
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		message = "malformed JSON: body ends unexpectedly"
+	case errors.As(err, &typeErr):
+		message = fmt.Sprintf("invalid value for %s: must be %s, got %s", typeErr.Field, jsonType(typeErr.Type), typeErr.Value)
+		detail = &spec.ErrorDetail{Field: typeErr.Field, Rule: "type", Message: "must be " + jsonType(typeErr.Type)}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		message = fmt.Sprintf("unknown field %q", field)
+		detail = &spec.ErrorDetail{Field: field, Rule: "unknown", Message: "is not a known field"}
+	case errors.As(err, &maxErr):
+		message = fmt.Sprintf("request body must not be larger than %d bytes", maxErr.Limit)
+	case errors.As(err, &timeErr):
+		message = fmt.Sprintf("invalid time %q, expected RFC 3339 like 2024-07-20T15:04:05Z", timeErr.Value)
+	default:
+		message = err.Error()
+	}
+
+	e := newError(r, spec.ErrorCodeInvalidRequest, message)
+	if detail != nil {
+		e.Details = []spec.ErrorDetail{*detail}
+	}
+	return e
+}
+
+// jsonType names a Go type the way a JSON client would think of it.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
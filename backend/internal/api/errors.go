@@ -0,0 +1,56 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// errorResponses are the error response builders of one operation, for the
+// helpers that several operations share.
+type errorResponses struct {
+	badRequest    func(spec.Error) *spec.Response
+	notFound      func(spec.Error) *spec.Response
+	conflict      func(spec.Error) *spec.Response
+	unprocessable func(spec.Error) *spec.Response
+	internal      func(spec.Error) *spec.Response
+}
+
+// newError builds the body of an error response, tagged with the ID of the
+// request so it can be found in the logs.
+func newError(r *http.Request, code spec.ErrorCode, message string) spec.Error {
+	return spec.Error{Code: code, Message: message, RequestID: requestID(r)}
+}
+
+// internalError logs err and hides it from the client behind a generic
+// message.
+func (api API) internalError(r *http.Request, msg string, err error, fields ...zap.Field) spec.Error {
+	fields = append(fields, zap.Error(err), zap.String("request_id", middleware.GetReqID(r.Context())))
+	api.logger.Error(msg, fields...)
+	return newError(r, spec.ErrorCodeInternal, "something went wrong, try again")
+}
+
+func requestID(r *http.Request) *string {
+	id := middleware.GetReqID(r.Context())
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// ParamErrorHandler reports the parameters the generated router fails to
+// bind, like a missing token, in the same shape as every other error.
+func ParamErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, http.StatusBadRequest, newError(r, spec.ErrorCodeInvalidRequest, err.Error()))
+}
+
+// writeError answers outside of a handler, where there is no spec.Response
+// to return.
+func writeError(w http.ResponseWriter, status int, e spec.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(e)
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	maxBulkInvites     = 500
+	maxBulkInviteBytes = 1 << 20
+)
+
+// readBulkInvites decodes the rows of a bulk invite from a JSON body, a CSV
+// body, or a CSV uploaded as multipart/form-data in the "file" field.
+func readBulkInvites(w http.ResponseWriter, r *http.Request) ([]spec.BulkInviteRow, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBulkInviteBytes)
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type: %w", err)
+	}
+
+	switch mediaType {
+	case "application/json":
+		var body spec.BulkInviteRequest
+		if err := decodeStrict(r.Body, &body); err != nil {
+			return nil, err
+		}
+		return body.Invites, nil
+	case "text/csv":
+		return readInvitesCSV(r.Body)
+	case "multipart/form-data":
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			return nil, fmt.Errorf("invalid upload: %w", err)
+		}
+		defer file.Close()
+		return readInvitesCSV(file)
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", mediaType)
+	}
+}
+
+// readInvitesCSV reads email,name records. A leading header row is skipped.
+func readInvitesCSV(r io.Reader) ([]spec.BulkInviteRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []spec.BulkInviteRow
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		// Spreadsheet exports often start with a byte order mark.
+		email := strings.TrimSpace(strings.TrimPrefix(record[0], "\ufeff"))
+		if first && strings.EqualFold(email, "email") {
+			continue
+		}
+
+		row := spec.BulkInviteRow{Email: email}
+		if len(record) > 1 {
+			if name := strings.TrimSpace(record[1]); name != "" {
+				row.Name = &name
+			}
+		}
+		rows = append(rows, row)
+	}
+}
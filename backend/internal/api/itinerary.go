@@ -0,0 +1,70 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/pgstore"
+	"slices"
+	"strings"
+	"time"
+)
+
+// buildItinerary lays a trip's activities out day by day in the trip's time
+// zone. Every day from the start to the end of the trip is listed, even the
+// empty ones, and activities outside that window get days of their own so
+// they are not lost. Days and the activities within them are in time order.
+func buildItinerary(trip pgstore.Trip, activities []pgstore.Activity) []spec.GetTripActivitiesResponseOuterArray {
+	loc := trip.Location()
+	startsAt := trip.StartsAt.Time.In(loc)
+	endsAt := trip.EndsAt.Time.In(loc)
+
+	var days []spec.GetTripActivitiesResponseOuterArray
+	index := make(map[time.Time]int)
+	addDay := func(date time.Time) int {
+		if i, ok := index[date]; ok {
+			return i
+		}
+		index[date] = len(days)
+		days = append(days, spec.GetTripActivitiesResponseOuterArray{
+			Date:       date,
+			Activities: []spec.GetTripActivitiesResponseInnerArray{},
+		})
+		return len(days) - 1
+	}
+
+	// Stepping by calendar day instead of 24h keeps midnight on days where
+	// the clocks change.
+	last := startOfDay(endsAt)
+	for date := startOfDay(startsAt); !date.After(last); date = date.AddDate(0, 0, 1) {
+		addDay(date)
+	}
+
+	for _, activity := range activities {
+		occursAt := activity.OccursAt.Time.In(loc)
+		i := addDay(startOfDay(occursAt))
+		days[i].Activities = append(days[i].Activities, spec.GetTripActivitiesResponseInnerArray{
+			ID:          activity.ID.String(),
+			Title:       activity.Title,
+			OccursAt:    occursAt,
+			OutOfWindow: occursAt.Before(startsAt) || occursAt.After(endsAt),
+		})
+	}
+
+	slices.SortFunc(days, func(a, b spec.GetTripActivitiesResponseOuterArray) int {
+		return a.Date.Compare(b.Date)
+	})
+	for _, day := range days {
+		slices.SortFunc(day.Activities, func(a, b spec.GetTripActivitiesResponseInnerArray) int {
+			if c := a.OccursAt.Compare(b.OccursAt); c != 0 {
+				return c
+			}
+			return strings.Compare(a.ID, b.ID)
+		})
+	}
+
+	return days
+}
+
+// startOfDay returns midnight of the day t falls on, in t's location.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
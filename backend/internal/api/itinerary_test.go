@@ -0,0 +1,175 @@
+package api
+
+import (
+	"SwallowGo/internal/pgstore"
+	"fmt"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestBuildItinerary(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(loc *time.Location, value string) pgtype.Timestamptz {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pgtype.Timestamptz{Time: parsed.UTC(), Valid: true}
+	}
+	activity := func(id int, title string, occursAt pgtype.Timestamptz) pgstore.Activity {
+		return pgstore.Activity{
+			ID:       uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", id)),
+			Title:    title,
+			OccursAt: occursAt,
+		}
+	}
+
+	// day is what a test expects of one day: its date in the trip's zone and
+	// the titles of its activities, marked with a * when out of the window.
+	type day struct {
+		date       string
+		activities []string
+	}
+
+	tests := []struct {
+		name       string
+		trip       pgstore.Trip
+		activities []pgstore.Activity
+		want       []day
+	}{
+		{
+			name: "empty days are listed",
+			trip: pgstore.Trip{
+				Timezone: "UTC",
+				StartsAt: at(time.UTC, "2025-03-14 09:00"),
+				EndsAt:   at(time.UTC, "2025-03-16 18:00"),
+			},
+			activities: []pgstore.Activity{
+				activity(1, "museum", at(time.UTC, "2025-03-15 10:00")),
+			},
+			want: []day{
+				{"2025-03-14", nil},
+				{"2025-03-15", []string{"museum"}},
+				{"2025-03-16", nil},
+			},
+		},
+		{
+			name: "activities of a day are in time order, then by id",
+			trip: pgstore.Trip{
+				Timezone: "UTC",
+				StartsAt: at(time.UTC, "2025-03-14 00:00"),
+				EndsAt:   at(time.UTC, "2025-03-14 23:00"),
+			},
+			activities: []pgstore.Activity{
+				activity(3, "dinner", at(time.UTC, "2025-03-14 20:00")),
+				activity(2, "lunch b", at(time.UTC, "2025-03-14 12:00")),
+				activity(4, "breakfast", at(time.UTC, "2025-03-14 08:00")),
+				activity(1, "lunch a", at(time.UTC, "2025-03-14 12:00")),
+			},
+			want: []day{
+				{"2025-03-14", []string{"breakfast", "lunch a", "lunch b", "dinner"}},
+			},
+		},
+		{
+			name: "activities outside the trip get days of their own",
+			trip: pgstore.Trip{
+				Timezone: "UTC",
+				StartsAt: at(time.UTC, "2025-03-14 09:00"),
+				EndsAt:   at(time.UTC, "2025-03-15 18:00"),
+			},
+			activities: []pgstore.Activity{
+				activity(1, "checkout", at(time.UTC, "2025-03-17 11:00")),
+				activity(2, "early", at(time.UTC, "2025-03-14 08:00")),
+				activity(3, "visa", at(time.UTC, "2025-03-01 10:00")),
+				activity(4, "late", at(time.UTC, "2025-03-15 19:00")),
+			},
+			want: []day{
+				{"2025-03-01", []string{"visa*"}},
+				{"2025-03-14", []string{"early*"}},
+				{"2025-03-15", []string{"late*"}},
+				{"2025-03-17", []string{"checkout*"}},
+			},
+		},
+		{
+			name: "days follow the trip's zone across the start of DST",
+			trip: pgstore.Trip{
+				Timezone: "America/New_York",
+				StartsAt: at(newYork, "2024-03-09 10:00"),
+				EndsAt:   at(newYork, "2024-03-11 22:00"),
+			},
+			activities: []pgstore.Activity{
+				// 04:30 UTC on the 10th, still the 9th in New York.
+				activity(1, "bar", at(newYork, "2024-03-09 23:30")),
+				activity(2, "brunch", at(newYork, "2024-03-10 03:30")),
+				// The 10th is 23 hours long, 23:30 is still on it.
+				activity(3, "show", at(newYork, "2024-03-10 23:30")),
+				activity(4, "flight", at(newYork, "2024-03-11 00:00")),
+			},
+			want: []day{
+				{"2024-03-09", []string{"bar"}},
+				{"2024-03-10", []string{"brunch", "show"}},
+				{"2024-03-11", []string{"flight"}},
+			},
+		},
+		{
+			name: "days follow the trip's zone across the end of DST",
+			trip: pgstore.Trip{
+				Timezone: "America/New_York",
+				StartsAt: at(newYork, "2024-11-02 10:00"),
+				EndsAt:   at(newYork, "2024-11-04 10:00"),
+			},
+			activities: []pgstore.Activity{
+				// The 3rd is 25 hours long.
+				activity(1, "late show", at(newYork, "2024-11-03 23:30")),
+				activity(2, "night walk", at(newYork, "2024-11-03 01:30")),
+			},
+			want: []day{
+				{"2024-11-02", nil},
+				{"2024-11-03", []string{"night walk", "late show"}},
+				{"2024-11-04", nil},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.trip.Location()
+			days := buildItinerary(tt.trip, tt.activities)
+
+			if len(days) != len(tt.want) {
+				t.Fatalf("got %d days, want %d: %+v", len(days), len(tt.want), days)
+			}
+
+			for i, want := range tt.want {
+				got := days[i]
+				if got.Date.Format("2006-01-02") != want.date {
+					t.Errorf("day %d is %s, want %s", i, got.Date.Format("2006-01-02"), want.date)
+				}
+				if got.Date.Location().String() != loc.String() || got.Date.Hour() != 0 || got.Date.Minute() != 0 {
+					t.Errorf("day %d starts at %s, want midnight in %s", i, got.Date, loc)
+				}
+
+				var titles []string
+				for _, activity := range got.Activities {
+					title := activity.Title
+					if activity.OutOfWindow {
+						title += "*"
+					}
+					titles = append(titles, title)
+				}
+				if fmt.Sprint(titles) != fmt.Sprint(want.activities) {
+					t.Errorf("day %s has %v, want %v", want.date, titles, want.activities)
+				}
+			}
+		})
+	}
+}
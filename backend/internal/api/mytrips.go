@@ -0,0 +1,145 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// defaultTripsPage is how many trips are listed when the caller doesn't say.
+const defaultTripsPage = 20
+
+// likeEscaper escapes the wildcards of a LIKE pattern, so the destination
+// filter matches them literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+var errInvalidCursor = errors.New("api: invalid cursor")
+
+// List the trips of the signed in user.
+// (GET /trips)
+func (api API) GetTrips(w http.ResponseWriter, r *http.Request, params spec.GetTripsParams) *spec.Response {
+	caller, ok := auth.UserFrom(r.Context())
+	if !ok {
+		return spec.GetTripsJSON401Response(newError(r, spec.ErrorCodeUnauthorized, "sign in first"))
+	}
+
+	limit := defaultTripsPage
+	if params.Limit != nil {
+		limit = min(max(*params.Limit, 1), 100)
+	}
+
+	arg := pgstore.ListUserTripsParams{
+		UserID: pgtype.UUID{Bytes: caller.ID, Valid: true},
+		Email:  caller.Email,
+		// One more than asked tells whether there is a next page.
+		RowLimit: int32(limit + 1),
+	}
+
+	if params.When != nil {
+		switch *params.When {
+		case "upcoming":
+			arg.Upcoming = true
+		case "past":
+			arg.Past = true
+		default:
+			return spec.GetTripsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "when must be upcoming or past"))
+		}
+	}
+
+	if params.Status != nil {
+		arg.Status = pgstore.NullTripStatus{TripStatus: pgstore.TripStatus(*params.Status), Valid: true}
+	}
+
+	if params.Destination != nil && strings.TrimSpace(*params.Destination) != "" {
+		arg.Destination = pgtype.Text{String: likeEscaper.Replace(strings.TrimSpace(*params.Destination)), Valid: true}
+	}
+
+	if params.Sort != nil {
+		switch *params.Sort {
+		case "starts_at":
+		case "-starts_at":
+			arg.Descending = true
+		default:
+			return spec.GetTripsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "sort must be starts_at or -starts_at"))
+		}
+	}
+
+	if params.Cursor != nil {
+		startsAt, id, descending, err := decodeTripCursor(*params.Cursor)
+		if err != nil {
+			return spec.GetTripsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid cursor"))
+		}
+		// Read the other way around, the cursor would skip or repeat trips.
+		if descending != arg.Descending {
+			return spec.GetTripsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "cursor does not match the sort order"))
+		}
+		arg.AfterStartsAt = pgtype.Timestamptz{Time: startsAt, Valid: true}
+		arg.AfterID = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	trips, err := api.store.ListUserTrips(r.Context(), arg)
+	if err != nil {
+		return spec.GetTripsJSON500Response(api.internalError(r, "failed to list trips", err))
+	}
+
+	var next *string
+	if len(trips) > limit {
+		trips = trips[:limit]
+		last := trips[limit-1]
+		cursor := encodeTripCursor(last.StartsAt.Time, last.ID, arg.Descending)
+		next = &cursor
+	}
+
+	res := spec.ListTripsResponse{Trips: make([]spec.TripSummary, len(trips)), NextCursor: next}
+	for i, trip := range trips {
+		// Every time is rendered in the zone the trip happens in.
+		loc := trip.Location()
+		res.Trips[i] = spec.TripSummary{
+			ID:          trip.ID.String(),
+			Destination: trip.Destination,
+			StartsAt:    trip.StartsAt.Time.In(loc),
+			EndsAt:      trip.EndsAt.Time.In(loc),
+			Timezone:    trip.Timezone,
+			Status:      tripStatuses[trip.Status],
+			OwnerName:   trip.OwnerName,
+			IsOwner:     (trip.OwnerID.Valid && trip.OwnerID.Bytes == caller.ID) || strings.EqualFold(trip.OwnerEmail, caller.Email),
+		}
+	}
+
+	return spec.GetTripsJSON200Response(res)
+}
+
+// encodeTripCursor points after the trip starting at startsAt with the given
+// ID, in the sort order the page was listed in. Postgres keeps microseconds,
+// so does the cursor.
+func encodeTripCursor(startsAt time.Time, id uuid.UUID, descending bool) string {
+	buf := make([]byte, 9, 9+len(id))
+	if descending {
+		buf[0] = 1
+	}
+	binary.BigEndian.PutUint64(buf[1:], uint64(startsAt.UnixMicro()))
+	return base64.RawURLEncoding.EncodeToString(append(buf, id[:]...))
+}
+
+func decodeTripCursor(cursor string) (time.Time, uuid.UUID, bool, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(raw) != 9+len(uuid.UUID{}) || raw[0] > 1 {
+		return time.Time{}, uuid.UUID{}, false, errInvalidCursor
+	}
+
+	id, err := uuid.FromBytes(raw[9:])
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, false, errInvalidCursor
+	}
+
+	return time.UnixMicro(int64(binary.BigEndian.Uint64(raw[1:9]))).UTC(), id, raw[0] == 1, nil
+}
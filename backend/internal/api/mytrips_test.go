@@ -0,0 +1,115 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestTripCursor(t *testing.T) {
+	startsAt := time.Date(2025, 3, 14, 9, 30, 15, 123456000, time.UTC)
+	id := uuid.New()
+
+	for _, descending := range []bool{false, true} {
+		gotStartsAt, gotID, gotDescending, err := decodeTripCursor(encodeTripCursor(startsAt, id, descending))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !gotStartsAt.Equal(startsAt) || gotID != id || gotDescending != descending {
+			t.Errorf("decoded %s %s %v, want %s %s %v", gotStartsAt, gotID, gotDescending, startsAt, id, descending)
+		}
+	}
+
+	for _, cursor := range []string{"", "not base64!", "AAAA", encodeTripCursor(startsAt, id, false) + "AA"} {
+		if _, _, _, err := decodeTripCursor(cursor); err == nil {
+			t.Errorf("decodeTripCursor(%q) accepted a malformed cursor", cursor)
+		}
+	}
+}
+
+func TestGetTripsPages(t *testing.T) {
+	fs := newFakeStore()
+	start := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		trip := pgstore.Trip{
+			ID:          uuid.New(),
+			Destination: "Trip",
+			Timezone:    "UTC",
+			StartsAt:    pgtype.Timestamptz{Time: start.AddDate(0, 0, i), Valid: true},
+			EndsAt:      pgtype.Timestamptz{Time: start.AddDate(0, 0, i+1), Valid: true},
+			Status:      pgstore.TripStatusConfirmed,
+		}
+		fs.trips[trip.ID] = trip
+	}
+	srv := newTestServer(t, fs)
+	user := auth.User{ID: uuid.New(), Email: "ada@example.com"}
+
+	list := func(t *testing.T, query url.Values) (spec.ListTripsResponse, int) {
+		t.Helper()
+
+		res := srv.do(t, http.MethodGet, "/trips?"+query.Encode(), &user)
+		var page spec.ListTripsResponse
+		if res.StatusCode == http.StatusOK {
+			readJSON(t, res, &page)
+		}
+		return page, res.StatusCode
+	}
+
+	for _, order := range []string{"starts_at", "-starts_at"} {
+		order := order
+		t.Run(order, func(t *testing.T) {
+			var seen []time.Time
+			query := url.Values{"sort": {order}, "limit": {"2"}}
+			for {
+				page, status := list(t, query)
+				if status != http.StatusOK {
+					t.Fatalf("status = %d, want %d", status, http.StatusOK)
+				}
+				for _, trip := range page.Trips {
+					seen = append(seen, trip.StartsAt)
+				}
+				if page.NextCursor == nil {
+					break
+				}
+				query.Set("cursor", *page.NextCursor)
+			}
+
+			if len(seen) != len(fs.trips) {
+				t.Fatalf("paged through %d trips, want %d", len(seen), len(fs.trips))
+			}
+			for i := 1; i < len(seen); i++ {
+				if seen[i].Before(seen[i-1]) != (order == "-starts_at") {
+					t.Fatalf("trips out of order: %v", seen)
+				}
+			}
+		})
+	}
+
+	t.Run("cursor of the other order", func(t *testing.T) {
+		page, _ := list(t, url.Values{"sort": {"-starts_at"}, "limit": {"2"}})
+		if page.NextCursor == nil {
+			t.Fatal("no next page")
+		}
+
+		calls := len(fs.listed)
+		for _, query := range []url.Values{
+			{"sort": {"starts_at"}, "cursor": {*page.NextCursor}},
+			// starts_at is the default order.
+			{"cursor": {*page.NextCursor}},
+		} {
+			if _, status := list(t, query); status != http.StatusBadRequest {
+				t.Errorf("%s: status = %d, want %d", query.Encode(), status, http.StatusBadRequest)
+			}
+		}
+		if len(fs.listed) != calls {
+			t.Errorf("a mismatched cursor reached the store")
+		}
+	})
+}
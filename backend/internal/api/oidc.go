@@ -0,0 +1,144 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/oidc"
+	"SwallowGo/internal/pgstore"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// oidcCookie keeps the state, nonce and code verifier of a sign-in started
+// with the provider until the browser comes back to the callback. Only the
+// browser that started the sign-in can finish it.
+const oidcCookie = "swallowgo_oidc"
+
+// oidcFlowTTL is how long a user has to get through the provider's pages.
+const oidcFlowTTL = 10 * time.Minute
+
+// oidcFlow is what a sign-in started with the provider must be finished with.
+type oidcFlow struct {
+	state    string
+	nonce    string
+	verifier string
+}
+
+// Sign in with the identity provider.
+// (GET /auth/oidc/login)
+func (api API) GetAuthOidcLogin(w http.ResponseWriter, r *http.Request) *spec.Response {
+	if api.provider == nil {
+		return spec.GetAuthOidcLoginJSON404Response(newError(r, spec.ErrorCodeNotFound, "sign-in with an identity provider is not configured"))
+	}
+
+	var flow oidcFlow
+	for _, value := range []*string{&flow.state, &flow.nonce, &flow.verifier} {
+		random, err := oidc.RandomString()
+		if err != nil {
+			return spec.GetAuthOidcLoginJSON500Response(api.internalError(r, "failed to start oidc sign-in", err))
+		}
+		*value = random
+	}
+
+	location, err := api.provider.AuthCodeURL(r.Context(), flow.state, flow.nonce, flow.verifier)
+	if err != nil {
+		return spec.GetAuthOidcLoginJSON500Response(api.internalError(r, "failed to start oidc sign-in", err))
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookie,
+		Value:    strings.Join([]string{flow.state, flow.nonce, flow.verifier}, "."),
+		Path:     "/auth/oidc",
+		MaxAge:   int(oidcFlowTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   secureRequest(r),
+		// Lax still sends it along the top-level redirect back from the
+		// provider.
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, location, http.StatusFound)
+
+	// The response is already written.
+	return nil
+}
+
+// Finish signing in with the identity provider.
+// (GET /auth/oidc/callback)
+func (api API) GetAuthOidcCallback(w http.ResponseWriter, r *http.Request, params spec.GetAuthOidcCallbackParams) *spec.Response {
+	if api.provider == nil {
+		return spec.GetAuthOidcCallbackJSON404Response(newError(r, spec.ErrorCodeNotFound, "sign-in with an identity provider is not configured"))
+	}
+
+	// A flow is good for one try, whatever its outcome.
+	flow, ok := oidcFlowFrom(r)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookie,
+		Path:     "/auth/oidc",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secureRequest(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if params.Error != nil {
+		return spec.GetAuthOidcCallbackJSON401Response(newError(r, spec.ErrorCodeUnauthorized, "the identity provider refused the sign-in: "+*params.Error))
+	}
+
+	if params.Code == nil || params.State == nil {
+		return spec.GetAuthOidcCallbackJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "code and state are required"))
+	}
+
+	if !ok || subtle.ConstantTimeCompare([]byte(*params.State), []byte(flow.state)) != 1 {
+		return spec.GetAuthOidcCallbackJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "sign-in expired or was started in another browser, start over"))
+	}
+
+	claims, err := api.provider.Exchange(r.Context(), *params.Code, flow.verifier, flow.nonce)
+	if err != nil {
+		switch {
+		case errors.Is(err, oidc.ErrExchange):
+			return spec.GetAuthOidcCallbackJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired authorization code, start over"))
+		case errors.Is(err, oidc.ErrInvalidIDToken):
+			api.logger.Warn("rejected oidc id token", zap.Error(err))
+			return spec.GetAuthOidcCallbackJSON401Response(newError(r, spec.ErrorCodeUnauthorized, "the identity provider's answer could not be verified"))
+		}
+		return spec.GetAuthOidcCallbackJSON500Response(api.internalError(r, "failed to exchange oidc code", err))
+	}
+
+	user, err := api.store.SignInWithIdentity(r.Context(), api.pool, pgstore.Identity{
+		Issuer:        api.provider.Issuer(),
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+	})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrUnverifiedEmail) {
+			return spec.GetAuthOidcCallbackJSON401Response(newError(r, spec.ErrorCodeUnauthorized, "the identity provider has not verified your e-mail"))
+		}
+		return spec.GetAuthOidcCallbackJSON500Response(api.internalError(r, "failed to sign in", err, zap.String("subject", claims.Subject)))
+	}
+
+	session, err := api.startSession(w, r, user)
+	if err != nil {
+		return spec.GetAuthOidcCallbackJSON500Response(api.internalError(r, "failed to issue session", err, zap.String("user_id", user.ID.String())))
+	}
+
+	return spec.GetAuthOidcCallbackJSON200Response(session)
+}
+
+func oidcFlowFrom(r *http.Request) (oidcFlow, bool) {
+	cookie, err := r.Cookie(oidcCookie)
+	if err != nil {
+		return oidcFlow{}, false
+	}
+
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return oidcFlow{}, false
+	}
+
+	return oidcFlow{state: parts[0], nonce: parts[1], verifier: parts[2]}, true
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/oidc"
+	"SwallowGo/internal/oidc/oidctest"
+	"SwallowGo/internal/pgstore"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestOidcCallback(t *testing.T) {
+	iss := oidctest.NewIssuer(t, "swallowgo")
+	known := pgstore.User{ID: uuid.New(), Email: "known@example.com"}
+
+	tests := []struct {
+		name string
+		edit func(claims map[string]any)
+		want int
+	}{
+		{name: "verified e-mail", edit: func(map[string]any) {}, want: http.StatusOK},
+		{name: "unverified e-mail", edit: func(c map[string]any) { c["email_verified"] = false }, want: http.StatusUnauthorized},
+		{name: "unverified e-mail as a string", edit: func(c map[string]any) { c["email_verified"] = "false" }, want: http.StatusUnauthorized},
+		{name: "no e-mail", edit: func(c map[string]any) { delete(c, "email") }, want: http.StatusUnauthorized},
+		// The e-mail only matters the first time the account is linked.
+		{name: "unverified e-mail of a known account", edit: func(c map[string]any) {
+			c["sub"] = "known"
+			c["email_verified"] = false
+		}, want: http.StatusOK},
+		{name: "nonce of another sign-in", edit: func(c map[string]any) { c["nonce"] = "another-nonce" }, want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newFakeStore()
+			fs.identities[[2]string{iss.URL, "known"}] = known
+			srv := newTestServerWithProvider(t, fs, oidc.NewProvider(oidc.Config{
+				Issuer:      iss.URL,
+				ClientID:    iss.ClientID,
+				RedirectURL: "http://localhost/auth/oidc/callback",
+				Scopes:      []string{"openid", "email"},
+			}, http.DefaultClient))
+			client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+			res, err := client.Get(srv.URL + "/auth/oidc/login")
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = res.Body.Close()
+			if res.StatusCode != http.StatusFound {
+				t.Fatalf("login status = %d, want %d", res.StatusCode, http.StatusFound)
+			}
+			location, err := url.Parse(res.Header.Get("Location"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			claims := iss.Claims(location.Query().Get("nonce"))
+			tt.edit(claims)
+			code := iss.Authorize(t, location.String(), iss.Sign(t, claims))
+
+			req, err := http.NewRequest(http.MethodGet, srv.URL+"/auth/oidc/callback?"+url.Values{
+				"code":  {code},
+				"state": {location.Query().Get("state")},
+			}.Encode(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, cookie := range res.Cookies() {
+				req.AddCookie(cookie)
+			}
+			res, err = client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = res.Body.Close() })
+
+			if res.StatusCode != tt.want {
+				t.Fatalf("callback status = %d, want %d", res.StatusCode, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				if len(fs.identities) != 1 {
+					t.Errorf("a rejected sign-in linked an account")
+				}
+				return
+			}
+
+			var session spec.SessionResponse
+			readJSON(t, res, &session)
+			if session.Token == "" {
+				t.Error("no session issued")
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// ValidateRequests checks every request against the operation doc declares
+// for it before the handler runs. Malformed parameters and bodies are
+// answered with 400 and bodies breaking the schema with 422, in the same
+// shape as the handlers' own errors. Requests doc knows nothing about are
+// left to the router.
+func ValidateRequests(doc *openapi3.T) (func(http.Handler) http.Handler, error) {
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to build openapi router: %w", err)
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+	// Uploads can be far larger than any JSON body. Their handlers read them
+	// from disk and check them, size included, themselves.
+	uploadOptions := *options
+	uploadOptions.ExcludeRequestBody = true
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			opts := options
+			if isMultipart(r) {
+				opts = &uploadOptions
+			} else {
+				r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    opts,
+			}); err != nil {
+				status, e := openapiError(r, err)
+				writeError(w, status, e)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func isMultipart(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// openapiError turns what openapi3filter found wrong with r into an error
+// response. Schema violations of the body are validation failures, anything
+// else means the request itself is malformed.
+func openapiError(r *http.Request, err error) (int, spec.Error) {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return http.StatusBadRequest, decodeError(r, err)
+	}
+
+	var (
+		invalid  []string
+		details  []spec.ErrorDetail
+		failures []error
+	)
+	if multi, ok := err.(openapi3.MultiError); ok {
+		failures = multi
+	} else {
+		failures = []error{err}
+	}
+
+	for _, failure := range failures {
+		var reqErr *openapi3filter.RequestError
+		if !errors.As(failure, &reqErr) {
+			invalid = append(invalid, failure.Error())
+			continue
+		}
+
+		if reqErr.RequestBody == nil {
+			if reqErr.Parameter != nil {
+				invalid = append(invalid, fmt.Sprintf("invalid %s parameter %s: %s", reqErr.Parameter.In, reqErr.Parameter.Name, failureReason(reqErr)))
+			} else {
+				invalid = append(invalid, failureReason(reqErr))
+			}
+			continue
+		}
+
+		if errors.Is(reqErr.Err, openapi3filter.ErrInvalidRequired) {
+			invalid = append(invalid, errEmptyBody.Error())
+			continue
+		}
+
+		var parseErr *openapi3filter.ParseError
+		if errors.As(reqErr.Err, &parseErr) {
+			invalid = append(invalid, decodeError(r, parseErr.RootCause()).Message)
+			continue
+		}
+
+		schemaErrs := schemaErrors(reqErr.Err)
+		if len(schemaErrs) == 0 {
+			invalid = append(invalid, "invalid request body: "+failureReason(reqErr))
+			continue
+		}
+		for _, schemaErr := range schemaErrs {
+			details = append(details, schemaDetail(schemaErr))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return http.StatusBadRequest, newError(r, spec.ErrorCodeInvalidRequest, strings.Join(invalid, "; "))
+	}
+
+	e := newError(r, spec.ErrorCodeValidationFailed, "invalid input")
+	e.Details = details
+	return http.StatusUnprocessableEntity, e
+}
+
+// failureReason is the error of a RequestError without the operation it was
+// found in, which the client already knows.
+func failureReason(err *openapi3filter.RequestError) string {
+	if err.Err == nil {
+		return err.Reason
+	}
+	if errors.Is(err.Err, openapi3filter.ErrInvalidRequired) {
+		return "value is required"
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	return err.Err.Error()
+}
+
+// schemaErrors collects the schema violations behind err.
+func schemaErrors(err error) []*openapi3.SchemaError {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		var errs []*openapi3.SchemaError
+		for _, e := range multi {
+			errs = append(errs, schemaErrors(e)...)
+		}
+		return errs
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return []*openapi3.SchemaError{schemaErr}
+	}
+	return nil
+}
+
+// schemaDetail describes a schema violation with the rule names and
+// messages the handlers' own validation uses.
+func schemaDetail(err *openapi3.SchemaError) spec.ErrorDetail {
+	field := pointerPath(err.JSONPointer())
+	detail := spec.ErrorDetail{Field: field, Rule: err.SchemaField, Message: err.Reason}
+
+	switch err.SchemaField {
+	case "required":
+		detail.Rule, detail.Message = "required", "is required"
+	case "additionalProperties", "properties":
+		// The pointer stops at the object, the unknown property is only
+		// named in the reason.
+		var property string
+		if _, scanErr := fmt.Sscanf(err.Reason, "property %q is unsupported", &property); scanErr == nil {
+			detail.Field = pointerPath(append(err.JSONPointer(), property))
+			detail.Rule, detail.Message = "unknown", "is not a known field"
+		}
+	case "type":
+		if types := err.Schema.Type.Slice(); len(types) > 0 {
+			detail.Message = "must be of type " + strings.Join(types, " or ")
+		}
+	case "format":
+		detail.Rule, detail.Message = err.Schema.Format, "must be a valid "+err.Schema.Format
+	case "minLength":
+		detail.Rule, detail.Message = "min", fmt.Sprintf("must be at least %d characters long", err.Schema.MinLength)
+	case "maxLength":
+		if err.Schema.MaxLength != nil {
+			detail.Rule, detail.Message = "max", fmt.Sprintf("must be at most %d characters long", *err.Schema.MaxLength)
+		}
+	case "enum":
+		values := make([]string, len(err.Schema.Enum))
+		for i, value := range err.Schema.Enum {
+			values[i] = fmt.Sprint(value)
+		}
+		detail.Rule, detail.Message = "oneof", "must be one of "+strings.Join(values, ", ")
+	}
+
+	return detail
+}
+
+// pointerPath writes a JSON pointer the way ErrorDetail.Field does, like
+// invites[2].email.
+func pointerPath(pointer []string) string {
+	var b strings.Builder
+	for _, part := range pointer {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
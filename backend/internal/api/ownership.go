@@ -0,0 +1,182 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/tokens"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Hand a trip over to one of its participants.
+// (POST /trips/{tripId}/ownership-transfer)
+func (api API) PostTripsTripIDOwnershipTransfer(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.TransferOwnershipRequest
+	if res := api.decodeBody(w, r, &body, errorResponses{
+		badRequest:    spec.PostTripsTripIDOwnershipTransferJSON400Response,
+		unprocessable: spec.PostTripsTripIDOwnershipTransferJSON422Response,
+	}); res != nil {
+		return res
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDOwnershipTransferJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	pid, err := uuid.Parse(body.ParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDOwnershipTransferJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.store.RequestOwnershipTransfer(r.Context(), api.pool, id, pid); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return spec.PostTripsTripIDOwnershipTransferJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+		case errors.Is(err, pgstore.ErrTripClosed):
+			return spec.PostTripsTripIDOwnershipTransferJSON409Response(newError(r, spec.ErrorCodeTripClosed, "trip can no longer be changed"))
+		case errors.Is(err, pgstore.ErrParticipantNotConfirmed):
+			return spec.PostTripsTripIDOwnershipTransferJSON409Response(newError(r, spec.ErrorCodeConflict, "the trip can only be handed over to a participant who accepted the invitation"))
+		}
+		return spec.PostTripsTripIDOwnershipTransferJSON500Response(api.internalError(r, "failed to request ownership transfer", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.PostTripsTripIDOwnershipTransferJSON202Response(nil)
+}
+
+// Withdraw the pending hand-over of a trip.
+// (DELETE /trips/{tripId}/ownership-transfer)
+func (api API) DeleteTripsTripIDOwnershipTransfer(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDOwnershipTransferJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.store.WithdrawOwnershipTransfer(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDOwnershipTransferJSON404Response(newError(r, spec.ErrorCodeNotFound, "no ownership transfer is pending"))
+		}
+		return spec.DeleteTripsTripIDOwnershipTransferJSON500Response(api.internalError(r, "failed to withdraw ownership transfer", err, zap.String("trip_id", tripID)))
+	}
+
+	return spec.DeleteTripsTripIDOwnershipTransferJSON204Response(nil)
+}
+
+// Shows the hand-over an ownership transfer link from the e-mail accepts.
+// (GET /participants/{participantId}/accept-ownership)
+func (api API) GetParticipantsParticipantIDAcceptOwnership(w http.ResponseWriter, r *http.Request, participantID string, params spec.GetParticipantsParticipantIDAcceptOwnershipParams) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(params.Token, tokens.PurposeOwnershipTransfer, id); err != nil {
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	stored, err := api.store.GetConfirmationToken(r.Context(), pgstore.GetConfirmationTokenParams{
+		TokenHash: tokens.Hash(params.Token),
+		Purpose:   string(tokens.PurposeOwnershipTransfer),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		}
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON500Response(api.internalError(r, "failed to get token", err, zap.String("participant_id", participantID)))
+	}
+
+	if !stored.ParticipantID.Valid || uuid.UUID(stored.ParticipantID.Bytes) != id {
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetParticipantsParticipantIDAcceptOwnershipJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+		}
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON500Response(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetParticipantsParticipantIDAcceptOwnershipJSON404Response(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+		}
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON500Response(api.internalError(r, "failed to get trip", err, zap.String("participant_id", participantID)))
+	}
+
+	transfer, err := api.store.GetPendingOwnershipTransfer(r.Context(), trip.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON500Response(api.internalError(r, "failed to get ownership transfer", err, zap.String("participant_id", participantID)))
+	}
+	if err != nil || transfer.ParticipantID != id {
+		return spec.GetParticipantsParticipantIDAcceptOwnershipJSON409Response(newError(r, spec.ErrorCodeConflict, "the owner withdrew the transfer or the trip changed hands since"))
+	}
+
+	loc := trip.Location()
+	return spec.GetParticipantsParticipantIDAcceptOwnershipJSON200Response(spec.OwnershipTransferPreview{
+		ParticipantID: participant.ID.String(),
+		TripID:        trip.ID.String(),
+		Destination:   trip.Destination,
+		StartsAt:      trip.StartsAt.Time.In(loc),
+		EndsAt:        trip.EndsAt.Time.In(loc),
+		Timezone:      trip.Timezone,
+		OwnerName:     trip.OwnerName,
+		Email:         types.Email(participant.Email),
+	})
+}
+
+// Take over a trip from the ownership transfer e-mail link.
+// (POST /participants/{participantId}/accept-ownership)
+func (api API) PostParticipantsParticipantIDAcceptOwnership(w http.ResponseWriter, r *http.Request, participantID string, params spec.PostParticipantsParticipantIDAcceptOwnershipParams) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PostParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+	}
+
+	if err := api.signer.Verify(params.Token, tokens.PurposeOwnershipTransfer, id); err != nil {
+		return spec.PostParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostParticipantsParticipantIDAcceptOwnershipJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+		}
+		return spec.PostParticipantsParticipantIDAcceptOwnershipJSON500Response(api.internalError(r, "failed to get participant", err, zap.String("participant_id", participantID)))
+	}
+
+	// A forwarded link is not enough to take a trip over, only the nominated
+	// participant can accept it. The user policy let the request through,
+	// there is a caller.
+	caller, _ := auth.UserFrom(r.Context())
+	linked := participant.UserID.Valid && uuid.UUID(participant.UserID.Bytes) == caller.ID
+	if !linked && !strings.EqualFold(participant.Email, caller.Email) {
+		return spec.PostParticipantsParticipantIDAcceptOwnershipJSON403Response(newError(r, spec.ErrorCodeForbidden, "sign in as the participant the trip is handed over to"))
+	}
+
+	if err := api.store.AcceptOwnershipWithToken(r.Context(), api.pool, id, tokens.Hash(params.Token)); err != nil {
+		switch {
+		case errors.Is(err, pgstore.ErrInvalidConfirmationToken):
+			return spec.PostParticipantsParticipantIDAcceptOwnershipJSON400Response(newError(r, spec.ErrorCodeInvalidToken, "invalid or expired token"))
+		case errors.Is(err, pgx.ErrNoRows):
+			return spec.PostParticipantsParticipantIDAcceptOwnershipJSON404Response(newError(r, spec.ErrorCodeNotFound, "participant not found"))
+		case errors.Is(err, pgstore.ErrNoOwnershipTransfer):
+			return spec.PostParticipantsParticipantIDAcceptOwnershipJSON409Response(newError(r, spec.ErrorCodeConflict, "the owner withdrew the transfer or the trip changed hands since"))
+		case errors.Is(err, pgstore.ErrParticipantNotConfirmed):
+			return spec.PostParticipantsParticipantIDAcceptOwnershipJSON409Response(newError(r, spec.ErrorCodeConflict, "accept the invitation to the trip before taking it over"))
+		case errors.Is(err, pgstore.ErrTripClosed):
+			return spec.PostParticipantsParticipantIDAcceptOwnershipJSON409Response(newError(r, spec.ErrorCodeTripClosed, "trip can no longer be changed"))
+		}
+		return spec.PostParticipantsParticipantIDAcceptOwnershipJSON500Response(api.internalError(r, "failed to accept ownership transfer", err, zap.String("participant_id", participantID)))
+	}
+
+	return spec.PostParticipantsParticipantIDAcceptOwnershipJSON204Response(nil)
+}
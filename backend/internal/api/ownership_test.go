@@ -0,0 +1,101 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/tokens"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestAcceptOwnership(t *testing.T) {
+	nominee := auth.User{ID: uuid.New(), Email: "ada@example.com"}
+	trip := pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Lisbon",
+		OwnerName:   "Grace",
+		OwnerEmail:  "grace@example.com",
+		Timezone:    "Europe/Lisbon",
+		StartsAt:    pgtype.Timestamptz{Time: time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC), Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: time.Date(2025, 3, 20, 18, 0, 0, 0, time.UTC), Valid: true},
+		Status:      pgstore.TripStatusConfirmed,
+	}
+
+	setup := func(linked bool) (*testServer, pgstore.Participant, string) {
+		fs := newFakeStore()
+		fs.trips[trip.ID] = trip
+		participant := pgstore.Participant{ID: uuid.New(), TripID: trip.ID, Email: nominee.Email, RsvpStatus: pgstore.RsvpStatusAccepted}
+		if linked {
+			// Linked to the account, which has since changed its e-mail.
+			participant.Email = "ada@old.example.com"
+			participant.UserID = pgtype.UUID{Bytes: nominee.ID, Valid: true}
+		}
+		fs.participants[participant.ID] = participant
+		fs.transfers[trip.ID] = pgstore.OwnershipTransfer{ID: uuid.New(), TripID: trip.ID, ParticipantID: participant.ID}
+
+		srv := newTestServer(t, fs)
+		return srv, participant, srv.issueToken(t, tokens.PurposeOwnershipTransfer, trip.ID, participant.ID)
+	}
+
+	t.Run("the link only shows the hand-over", func(t *testing.T) {
+		srv, participant, token := setup(false)
+
+		res := srv.do(t, http.MethodGet, "/participants/"+participant.ID.String()+"/accept-ownership?token="+token, nil)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusOK)
+		}
+
+		var preview spec.OwnershipTransferPreview
+		readJSON(t, res, &preview)
+		if preview.Destination != trip.Destination || preview.OwnerName != trip.OwnerName || string(preview.Email) != nominee.Email {
+			t.Errorf("preview = %+v", preview)
+		}
+		if len(srv.store.accepted) != 0 {
+			t.Fatalf("following the link took the trip over")
+		}
+	})
+
+	t.Run("the link of a withdrawn transfer", func(t *testing.T) {
+		srv, participant, token := setup(false)
+		delete(srv.store.transfers, trip.ID)
+
+		res := srv.do(t, http.MethodGet, "/participants/"+participant.ID.String()+"/accept-ownership?token="+token, nil)
+		if res.StatusCode != http.StatusConflict {
+			t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusConflict)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		linked bool
+		caller *auth.User
+		want   int
+	}{
+		{"anonymous", false, nil, http.StatusUnauthorized},
+		{"someone else", false, &auth.User{ID: uuid.New(), Email: "mallory@example.com"}, http.StatusForbidden},
+		{"nominee by e-mail", false, &auth.User{ID: uuid.New(), Email: "ADA@example.com"}, http.StatusNoContent},
+		{"nominee by account", true, &nominee, http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			srv, participant, token := setup(tt.linked)
+
+			res := srv.do(t, http.MethodPost, "/participants/"+participant.ID.String()+"/accept-ownership?token="+token, tt.caller)
+			if res.StatusCode != tt.want {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.want)
+			}
+
+			accepted := len(srv.store.accepted) == 1 && srv.store.accepted[0] == participant.ID
+			if accepted != (tt.want == http.StatusNoContent) {
+				t.Errorf("accepted = %v", srv.store.accepted)
+			}
+		})
+	}
+}
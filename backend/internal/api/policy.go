@@ -0,0 +1,202 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// role is what the caller may do on a trip. Roles are ordered, each one may
+// do everything the roles below it can.
+type role int
+
+const (
+	// roleNone is the role of callers who don't belong to the trip.
+	roleNone role = iota
+	roleViewer
+	roleMember
+	roleCoOrganiser
+	// roleOwner is not stored with the participants, it follows from the
+	// owner of the trip.
+	roleOwner
+)
+
+var participantRoles = map[pgstore.ParticipantRole]role{
+	pgstore.ParticipantRoleViewer:      roleViewer,
+	pgstore.ParticipantRoleMember:      roleMember,
+	pgstore.ParticipantRoleCoOrganiser: roleCoOrganiser,
+}
+
+var participantRoleValues = map[pgstore.ParticipantRole]spec.ParticipantRole{
+	pgstore.ParticipantRoleViewer:      spec.ParticipantRoleViewer,
+	pgstore.ParticipantRoleMember:      spec.ParticipantRoleMember,
+	pgstore.ParticipantRoleCoOrganiser: spec.ParticipantRoleCoOrganiser,
+}
+
+// forbiddenMessages explain what a caller below the role needed was missing.
+var forbiddenMessages = map[role]string{
+	roleViewer:      "only the trip owner and its participants can access it",
+	roleMember:      "viewers can only look at the trip",
+	roleCoOrganiser: "only the trip owner and its co-organisers can do this",
+	roleOwner:       "only the trip owner can do this",
+}
+
+// policies maps the x-go-middlewares tag of an operation to the minimum role
+// it requires. public operations are open to anyone, user ones to anyone
+// signed in.
+var policies = map[string]role{
+	"viewer":    roleViewer,
+	"member":    roleMember,
+	"organiser": roleCoOrganiser,
+	"owner":     roleOwner,
+}
+
+const (
+	policyPublic = "public"
+	policyUser   = "user"
+)
+
+type roleKey struct{}
+
+// roleFrom returns the role the caller was granted on the trip of the request
+// by its policy.
+func roleFrom(ctx context.Context) role {
+	r, _ := ctx.Value(roleKey{}).(role)
+	return r
+}
+
+// Policies are the middlewares the operations of the spec are tagged with.
+// The generated router refuses to start without one of them, and
+// CheckPolicies makes sure no operation goes untagged.
+func (api API) Policies() spec.Middlewares {
+	return spec.Middlewares{
+		Public:    func(next http.Handler) http.Handler { return next },
+		User:      requireUser,
+		Viewer:    api.requireRole(policies["viewer"]),
+		Member:    api.requireRole(policies["member"]),
+		Organiser: api.requireRole(policies["organiser"]),
+		Owner:     api.requireRole(policies["owner"]),
+	}
+}
+
+// CheckPolicies fails unless every operation of doc is tagged with exactly one
+// known policy, so a new operation can't slip through unguarded.
+func CheckPolicies(doc *openapi3.T) error {
+	var missing []string
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			tags, _ := op.Extensions["x-go-middlewares"].([]any)
+			if len(tags) != 1 || !knownPolicy(tags[0]) {
+				missing = append(missing, method+" "+path)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("api: operations without a policy: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func knownPolicy(tag any) bool {
+	name, _ := tag.(string)
+	_, ok := policies[name]
+	return ok || name == policyPublic || name == policyUser
+}
+
+func requireUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := auth.UserFrom(r.Context()); !ok {
+			writeError(w, http.StatusUnauthorized, newError(r, spec.ErrorCodeUnauthorized, "sign in first"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requireRole lets the request through when the caller holds at least min on
+// the trip named by its path, and puts the role they hold on its context.
+func (api API) requireRole(min role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, ok := auth.UserFrom(r.Context())
+			if !ok {
+				writeError(w, http.StatusUnauthorized, newError(r, spec.ErrorCodeUnauthorized, "sign in first"))
+				return
+			}
+
+			tripID, err := uuid.Parse(chi.URLParam(r, "tripId"))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid"))
+				return
+			}
+
+			granted, err := api.tripRole(r.Context(), tripID, user)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					writeError(w, http.StatusNotFound, newError(r, spec.ErrorCodeNotFound, "trip not found"))
+					return
+				}
+				writeError(w, http.StatusInternalServerError, api.internalError(r, "failed to get trip role", err, zap.String("trip_id", tripID.String())))
+				return
+			}
+
+			if granted < min {
+				writeError(w, http.StatusForbidden, newError(r, spec.ErrorCodeForbidden, forbiddenMessages[min]))
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), roleKey{}, granted)))
+		})
+	}
+}
+
+// tripRole returns the role user holds on the trip. The owner and the
+// participants are recognised by their account or, until they first sign in
+// and get linked to it, by their e-mail. A participant linked to the account
+// wins over one that only shares its e-mail.
+func (api API) tripRole(ctx context.Context, tripID uuid.UUID, user auth.User) (role, error) {
+	trip, err := api.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return roleNone, err
+	}
+
+	if (trip.OwnerID.Valid && trip.OwnerID.Bytes == user.ID) || strings.EqualFold(trip.OwnerEmail, user.Email) {
+		return roleOwner, nil
+	}
+
+	participant, err := api.store.GetTripParticipantRole(ctx, pgstore.GetTripParticipantRoleParams{
+		TripID: tripID,
+		UserID: pgtype.UUID{Bytes: user.ID, Valid: true},
+		Email:  user.Email,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return roleNone, nil
+		}
+		return roleNone, err
+	}
+
+	// Declined invitations are left out by the query. Until they accept,
+	// invitees may look at the trip but not change it.
+	granted := participantRoles[participant.Role]
+	if participant.RsvpStatus != pgstore.RsvpStatusAccepted {
+		granted = min(granted, roleViewer)
+	}
+
+	return granted, nil
+}
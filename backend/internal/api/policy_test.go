@@ -0,0 +1,184 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/auth"
+	"SwallowGo/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+func TestPolicies(t *testing.T) {
+	fs := newFakeStore()
+
+	owner := auth.User{ID: uuid.New(), Email: "owner@example.com"}
+	trip := pgstore.Trip{ID: uuid.New(), OwnerEmail: owner.Email, OwnerID: pgtype.UUID{Bytes: owner.ID, Valid: true}}
+	fs.trips[trip.ID] = trip
+
+	// join puts a participant on the trip and returns the user they sign in as.
+	join := func(email string, role pgstore.ParticipantRole, rsvp pgstore.RsvpStatus) *auth.User {
+		user := auth.User{ID: uuid.New(), Email: email}
+		fs.participants[uuid.New()] = pgstore.Participant{
+			TripID:     trip.ID,
+			Email:      email,
+			UserID:     pgtype.UUID{Bytes: user.ID, Valid: true},
+			Role:       role,
+			RsvpStatus: rsvp,
+		}
+		return &user
+	}
+
+	callers := map[string]*auth.User{
+		"anonymous": nil,
+		"owner":     &owner,
+		// Owners who have not signed in since the trip was created are only
+		// known by their e-mail.
+		"owner by e-mail": {ID: uuid.New(), Email: "OWNER@example.com"},
+		"co_organiser":    join("co@example.com", pgstore.ParticipantRoleCoOrganiser, pgstore.RsvpStatusAccepted),
+		"member":          join("member@example.com", pgstore.ParticipantRoleMember, pgstore.RsvpStatusAccepted),
+		"viewer":          join("viewer@example.com", pgstore.ParticipantRoleViewer, pgstore.RsvpStatusAccepted),
+		"pending member":  join("pending@example.com", pgstore.ParticipantRoleMember, pgstore.RsvpStatusPending),
+		"maybe organiser": join("maybe@example.com", pgstore.ParticipantRoleCoOrganiser, pgstore.RsvpStatusMaybe),
+		"declined member": join("declined@example.com", pgstore.ParticipantRoleMember, pgstore.RsvpStatusDeclined),
+		"non-participant": {ID: uuid.New(), Email: "stranger@example.com"},
+	}
+
+	// The role each caller is granted, roleNone standing for 401 and 403 alike.
+	granted := map[string]role{
+		"owner":           roleOwner,
+		"owner by e-mail": roleOwner,
+		"co_organiser":    roleCoOrganiser,
+		"member":          roleMember,
+		"viewer":          roleViewer,
+		"pending member":  roleViewer,
+		"maybe organiser": roleViewer,
+	}
+
+	sessions := auth.NewSessions([]byte("test session secret"), time.Hour)
+	si := API{store: fs, logger: zap.NewNop(), sessions: sessions}
+	middlewares := si.Policies()
+	tags := map[string]func(http.Handler) http.Handler{
+		policyPublic: middlewares.Public,
+		policyUser:   middlewares.User,
+		"viewer":     middlewares.Viewer,
+		"member":     middlewares.Member,
+		"organiser":  middlewares.Organiser,
+		"owner":      middlewares.Owner,
+	}
+
+	for tag, middleware := range tags {
+		for name, caller := range callers {
+			tag, middleware, name, caller := tag, middleware, name, caller
+			t.Run(tag+"/"+name, func(t *testing.T) {
+				var seen role
+				r := chi.NewRouter()
+				r.Use(Authenticate(sessions))
+				r.With(middleware).Get("/trips/{tripId}", func(w http.ResponseWriter, r *http.Request) {
+					seen = roleFrom(r.Context())
+					w.WriteHeader(http.StatusNoContent)
+				})
+
+				req := httptest.NewRequest(http.MethodGet, "/trips/"+trip.ID.String(), nil)
+				if caller != nil {
+					session, err := sessions.Issue(*caller)
+					if err != nil {
+						t.Fatal(err)
+					}
+					req.Header.Set("Authorization", "Bearer "+session.Token)
+				}
+				rec := httptest.NewRecorder()
+				r.ServeHTTP(rec, req)
+
+				want := http.StatusNoContent
+				switch min, ok := policies[tag]; {
+				case tag == policyPublic:
+				case caller == nil:
+					want = http.StatusUnauthorized
+				case ok && granted[name] < min:
+					want = http.StatusForbidden
+				}
+
+				if rec.Code != want {
+					t.Fatalf("status = %d, want %d: %s", rec.Code, want, rec.Body)
+				}
+				if _, ok := policies[tag]; ok && want == http.StatusNoContent && seen != granted[name] {
+					t.Errorf("role on the context = %d, want %d", seen, granted[name])
+				}
+			})
+		}
+	}
+}
+
+func TestRequireRoleUnknownTrip(t *testing.T) {
+	srv := newTestServer(t, newFakeStore())
+	user := auth.User{ID: uuid.New(), Email: "ada@example.com"}
+
+	res := srv.do(t, http.MethodGet, "/trips/"+uuid.NewString()+"/participants", &user)
+	if res.StatusCode != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestOnlyOwnerRemovesCoOrganisers(t *testing.T) {
+	owner := auth.User{ID: uuid.New(), Email: "owner@example.com"}
+	organiser := auth.User{ID: uuid.New(), Email: "co@example.com"}
+	tripID := uuid.New()
+
+	setup := func() (*testServer, map[string]uuid.UUID) {
+		fs := newFakeStore()
+		fs.trips[tripID] = pgstore.Trip{ID: tripID, OwnerEmail: owner.Email, OwnerID: pgtype.UUID{Bytes: owner.ID, Valid: true}}
+		ids := map[string]uuid.UUID{}
+		for name, role := range map[string]pgstore.ParticipantRole{
+			"caller": pgstore.ParticipantRoleCoOrganiser,
+			"other":  pgstore.ParticipantRoleCoOrganiser,
+			"member": pgstore.ParticipantRoleMember,
+		} {
+			participant := pgstore.Participant{ID: uuid.New(), TripID: tripID, Email: name + "@example.com", Role: role, RsvpStatus: pgstore.RsvpStatusAccepted}
+			if name == "caller" {
+				participant.Email = organiser.Email
+				participant.UserID = pgtype.UUID{Bytes: organiser.ID, Valid: true}
+			}
+			fs.participants[participant.ID] = participant
+			ids[name] = participant.ID
+		}
+		return newTestServer(t, fs), ids
+	}
+
+	tests := []struct {
+		caller auth.User
+		target string
+		want   int
+	}{
+		{organiser, "other", http.StatusForbidden},
+		{organiser, "member", http.StatusNoContent},
+		{owner, "other", http.StatusNoContent},
+		{owner, "member", http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		srv, ids := setup()
+		res := srv.do(t, http.MethodDelete, "/trips/"+tripID.String()+"/participants/"+ids[tt.target].String(), &tt.caller)
+		if res.StatusCode != tt.want {
+			t.Errorf("%s removing %s: status = %d, want %d", tt.caller.Email, tt.target, res.StatusCode, tt.want)
+			continue
+		}
+
+		if tt.want == http.StatusForbidden {
+			var body spec.Error
+			readJSON(t, res, &body)
+			if body.Code != spec.ErrorCodeForbidden {
+				t.Errorf("%s removing %s: code = %v, want forbidden", tt.caller.Email, tt.target, body.Code)
+			}
+			if _, still := srv.store.participants[ids[tt.target]]; !still {
+				t.Errorf("%s removing %s: participant is gone", tt.caller.Email, tt.target)
+			}
+		}
+	}
+}
@@ -23,6 +23,175 @@ import (
 	"github.com/go-chi/render"
 )
 
+// Defines values for BulkInviteResultStatus.
+var (
+	UnknownBulkInviteResultStatus = BulkInviteResultStatus{}
+
+	BulkInviteResultStatusCreated = BulkInviteResultStatus{"created"}
+
+	BulkInviteResultStatusDuplicate = BulkInviteResultStatus{"duplicate"}
+
+	BulkInviteResultStatusInvalid = BulkInviteResultStatus{"invalid"}
+)
+
+// Defines values for ErrorCode.
+var (
+	UnknownErrorCode = ErrorCode{}
+
+	ErrorCodeAlreadyAnswered = ErrorCode{"already_answered"}
+
+	ErrorCodeConflict = ErrorCode{"conflict"}
+
+	ErrorCodeDuplicateParticipant = ErrorCode{"duplicate_participant"}
+
+	ErrorCodeForbidden = ErrorCode{"forbidden"}
+
+	ErrorCodeInternal = ErrorCode{"internal"}
+
+	ErrorCodeInvalidRequest = ErrorCode{"invalid_request"}
+
+	ErrorCodeInvalidToken = ErrorCode{"invalid_token"}
+
+	ErrorCodeInvalidTransition = ErrorCode{"invalid_transition"}
+
+	ErrorCodeNotFound = ErrorCode{"not_found"}
+
+	ErrorCodeTripClosed = ErrorCode{"trip_closed"}
+
+	ErrorCodeUnauthorized = ErrorCode{"unauthorized"}
+
+	ErrorCodeValidationFailed = ErrorCode{"validation_failed"}
+)
+
+// Defines values for LinkCategory.
+var (
+	UnknownLinkCategory = LinkCategory{}
+
+	LinkCategoryBooking = LinkCategory{"booking"}
+
+	LinkCategoryDocument = LinkCategory{"document"}
+
+	LinkCategoryMap = LinkCategory{"map"}
+
+	LinkCategoryTicket = LinkCategory{"ticket"}
+)
+
+// Defines values for LinkPreviewStatus.
+var (
+	UnknownLinkPreviewStatus = LinkPreviewStatus{}
+
+	LinkPreviewStatusFailed = LinkPreviewStatus{"failed"}
+
+	LinkPreviewStatusPending = LinkPreviewStatus{"pending"}
+
+	LinkPreviewStatusReady = LinkPreviewStatus{"ready"}
+)
+
+// Defines values for ParticipantRole.
+var (
+	UnknownParticipantRole = ParticipantRole{}
+
+	ParticipantRoleCoOrganiser = ParticipantRole{"co_organiser"}
+
+	ParticipantRoleMember = ParticipantRole{"member"}
+
+	ParticipantRoleViewer = ParticipantRole{"viewer"}
+)
+
+// Defines values for RsvpStatus.
+var (
+	UnknownRsvpStatus = RsvpStatus{}
+
+	RsvpStatusAccepted = RsvpStatus{"accepted"}
+
+	RsvpStatusDeclined = RsvpStatus{"declined"}
+
+	RsvpStatusMaybe = RsvpStatus{"maybe"}
+
+	RsvpStatusPending = RsvpStatus{"pending"}
+)
+
+// Defines values for TripStatus.
+var (
+	UnknownTripStatus = TripStatus{}
+
+	TripStatusArchived = TripStatus{"archived"}
+
+	TripStatusCancelled = TripStatus{"cancelled"}
+
+	TripStatusCompleted = TripStatus{"completed"}
+
+	TripStatusConfirmed = TripStatus{"confirmed"}
+
+	TripStatusDraft = TripStatus{"draft"}
+
+	TripStatusInProgress = TripStatus{"in_progress"}
+
+	TripStatusPendingConfirmation = TripStatus{"pending_confirmation"}
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ActivityID  *string             `json:"activity_id"`
+	ContentType string              `json:"content_type"`
+	CreatedAt   time.Time           `json:"created_at"`
+	FileName    string              `json:"file_name"`
+	ID          string              `json:"id"`
+	SizeBytes   int64               `json:"size_bytes"`
+	UploadedBy  openapi_types.Email `json:"uploaded_by"`
+}
+
+// BulkInviteRequest defines model for BulkInviteRequest.
+type BulkInviteRequest struct {
+	Invites []BulkInviteRow `json:"invites" validate:"required,max=500"`
+}
+
+// BulkInviteResponse defines model for BulkInviteResponse.
+type BulkInviteResponse struct {
+	Created    int                `json:"created"`
+	Duplicates int                `json:"duplicates"`
+	Invalid    int                `json:"invalid"`
+	Results    []BulkInviteResult `json:"results"`
+}
+
+// BulkInviteResult defines model for BulkInviteResult.
+type BulkInviteResult struct {
+	Email string  `json:"email"`
+	Error *string `json:"error"`
+
+	// The new participant, or the existing one for duplicates.
+	ParticipantID *string `json:"participantId"`
+
+	// 1-based position of the invite in the input, not counting a CSV header.
+	Row    int                    `json:"row"`
+	Status BulkInviteResultStatus `json:"status"`
+}
+
+// BulkInviteRow defines model for BulkInviteRow.
+type BulkInviteRow struct {
+	Email string  `json:"email" validate:"required,email"`
+	Name  *string `json:"name,omitempty" validate:"omitempty,max=255"`
+}
+
+// CancelTripRequest defines model for CancelTripRequest.
+type CancelTripRequest struct {
+	// Shared with the participants in the cancellation e-mail.
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ConfirmParticipantRequest defines model for ConfirmParticipantRequest.
+type ConfirmParticipantRequest struct {
+	// Optional message to the trip owner.
+	Comment *string `json:"comment,omitempty" validate:"omitempty,max=1000"`
+	Name    *string `json:"name,omitempty" validate:"omitempty,max=255"`
+
+	// Dietary or accessibility notes.
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+
+	// Phone number in E.164 format, e.g. +5511999999999.
+	Phone *string `json:"phone,omitempty" validate:"omitempty,e164"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
 	OccursAt time.Time `json:"occurs_at" validate:"required"`
@@ -34,10 +203,20 @@ type CreateActivityResponse struct {
 	ActivityID string `json:"activityId"`
 }
 
+// CreateAttachmentResponse defines model for CreateAttachmentResponse.
+type CreateAttachmentResponse struct {
+	AttachmentID string `json:"attachmentId"`
+}
+
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
-	Title string `json:"title" validate:"required"`
-	URL   string `json:"url" validate:"required,url"`
+	Category    *LinkCategory `json:"category,omitempty"`
+	Description *string       `json:"description,omitempty" validate:"omitempty,max=1000"`
+
+	// Links are listed by ascending sort_order, then by creation. Defaults to 0.
+	SortOrder *int32 `json:"sort_order,omitempty"`
+	Title     string `json:"title" validate:"required,max=255"`
+	URL       string `json:"url" validate:"required,url,max=255"`
 }
 
 // CreateLinkResponse defines model for CreateLinkResponse.
@@ -49,10 +228,17 @@ type CreateLinkResponse struct {
 type CreateTripRequest struct {
 	Destination    string                `json:"destination" validate:"required,min=4"`
 	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
-	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+
+	// Must be after starts_at.
+	EndsAt     time.Time           `json:"ends_at" validate:"required"`
+	OwnerEmail openapi_types.Email `json:"owner_email" validate:"required,email"`
+	OwnerName  string              `json:"owner_name" validate:"required"`
+
+	// Can't be in the past.
+	StartsAt time.Time `json:"starts_at" validate:"required,notpast"`
+
+	// IANA time zone the trip happens in, used to render its dates. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
 }
 
 // CreateTripResponse defines model for CreateTripResponse.
@@ -60,9 +246,45 @@ type CreateTripResponse struct {
 	TripID string `json:"tripId"`
 }
 
+// DuplicateParticipantError defines model for DuplicateParticipantError.
+type DuplicateParticipantError struct {
+	// Machine-readable reason of an error. invalid_request: malformed body or parameters (400). invalid_token: missing, expired or used token (400). validation_failed: well-formed but invalid input, see details (422). not_found: 404. trip_closed, invalid_transition, already_answered, duplicate_participant, conflict: the request clashes with the current state (409). unauthorized: missing, invalid or expired session, or a sign-in the identity provider refused (401). forbidden: the caller's role on the trip does not allow it (403). internal: 500.
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+
+	// The participant the e-mail was already invited as.
+	ParticipantID string `json:"participantId"`
+
+	// Identifies the request in the server logs.
+	RequestID *string `json:"request_id,omitempty"`
+}
+
 // Bad request
 type Error struct {
+	// Machine-readable reason of an error. invalid_request: malformed body or parameters (400). invalid_token: missing, expired or used token (400). validation_failed: well-formed but invalid input, see details (422). not_found: 404. trip_closed, invalid_transition, already_answered, duplicate_participant, conflict: the request clashes with the current state (409). unauthorized: missing, invalid or expired session, or a sign-in the identity provider refused (401). forbidden: the caller's role on the trip does not allow it (403). internal: 500.
+	Code ErrorCode `json:"code"`
+
+	// What is wrong with each offending field, when the request failed validation.
+	Details []ErrorDetail `json:"details,omitempty"`
+	Message string        `json:"message"`
+
+	// Identifies the request in the server logs.
+	RequestID *string `json:"request_id,omitempty"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// JSON path of the offending field, e.g. ends_at or invites[2].email.
+	Field   string `json:"field"`
 	Message string `json:"message"`
+
+	// Name of the rule the field broke.
+	Rule string `json:"rule"`
+}
+
+// GetAttachmentsResponse defines model for GetAttachmentsResponse.
+type GetAttachmentsResponse struct {
+	Attachments []Attachment `json:"attachments"`
 }
 
 // GetLinksResponse defines model for GetLinksResponse.
@@ -72,9 +294,22 @@ type GetLinksResponse struct {
 
 // GetLinksResponseArray defines model for GetLinksResponseArray.
 type GetLinksResponseArray struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	URL   string `json:"url"`
+	// E-mail of who added the link, when it is known.
+	AddedBy     *openapi_types.Email `json:"added_by"`
+	Category    *LinkCategory        `json:"category,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	Description *string              `json:"description"`
+	ID          string               `json:"id"`
+
+	// OpenGraph metadata of the linked page, present once preview_status is ready.
+	Preview *LinkPreview `json:"preview,omitempty"`
+
+	// pending: the page was not fetched yet. ready: preview holds what the page says about itself. failed: the page could not be fetched.
+	PreviewStatus LinkPreviewStatus `json:"preview_status"`
+	SortOrder     int32             `json:"sort_order"`
+	Title         string            `json:"title"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	URL           string            `json:"url"`
 }
 
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
@@ -86,13 +321,18 @@ type GetTripActivitiesResponse struct {
 type GetTripActivitiesResponseInnerArray struct {
 	ID       string    `json:"id"`
 	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+
+	// Whether the activity falls before the trip starts or after it ends.
+	OutOfWindow bool   `json:"out_of_window"`
+	Title       string `json:"title"`
 }
 
 // GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
 type GetTripActivitiesResponseOuterArray struct {
 	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
-	Date       time.Time                             `json:"date"`
+
+	// Start of the day in the trip's time zone.
+	Date time.Time `json:"date"`
 }
 
 // GetTripDetailsResponse defines model for GetTripDetailsResponse.
@@ -102,16 +342,23 @@ type GetTripDetailsResponse struct {
 
 // GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
 type GetTripDetailsResponseTripObj struct {
-	Destination string    `json:"destination"`
-	EndsAt      time.Time `json:"ends_at"`
-	ID          string    `json:"id"`
-	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+	CancellationReason *string                `json:"cancellation_reason"`
+	CancelledAt        *time.Time             `json:"cancelled_at"`
+	Destination        string                 `json:"destination"`
+	EndsAt             time.Time              `json:"ends_at"`
+	ID                 string                 `json:"id"`
+	IsConfirmed        bool                   `json:"is_confirmed"`
+	Rsvp               RsvpCounts             `json:"rsvp"`
+	StartsAt           time.Time              `json:"starts_at"`
+	Status             TripStatus             `json:"status"`
+	StatusHistory      []TripStatusTransition `json:"status_history"`
+	Timezone           string                 `json:"timezone"`
 }
 
 // GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
 type GetTripParticipantsResponse struct {
 	Participants []GetTripParticipantsResponseArray `json:"participants"`
+	Rsvp         RsvpCounts                         `json:"rsvp"`
 }
 
 // GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
@@ -120,11 +367,39 @@ type GetTripParticipantsResponseArray struct {
 	ID          string              `json:"id"`
 	IsConfirmed bool                `json:"is_confirmed"`
 	Name        *string             `json:"name"`
+	Notes       *string             `json:"notes"`
+	Phone       *string             `json:"phone"`
+	RespondedAt *time.Time          `json:"responded_at"`
+
+	// What a participant may do on the trip. co_organiser: everything the owner can but deleting or cancelling the trip. member: add and edit activities, links and files. viewer: read only.
+	Role        ParticipantRole `json:"role"`
+	RsvpComment *string         `json:"rsvp_comment"`
+	RsvpStatus  RsvpStatus      `json:"rsvp_status"`
+}
+
+// InvitationPreview defines model for InvitationPreview.
+type InvitationPreview struct {
+	Answer        RsvpStatus `json:"answer"`
+	Destination   string     `json:"destination"`
+	EndsAt        time.Time  `json:"ends_at"`
+	OwnerName     string     `json:"owner_name"`
+	ParticipantID string     `json:"participant_id"`
+	RsvpStatus    RsvpStatus `json:"rsvp_status"`
+	StartsAt      time.Time  `json:"starts_at"`
+	Timezone      string     `json:"timezone"`
+	TripID        string     `json:"trip_id"`
 }
 
 // InviteParticipantRequest defines model for InviteParticipantRequest.
 type InviteParticipantRequest struct {
 	Email openapi_types.Email `json:"email" validate:"required,email"`
+	Name  *string             `json:"name,omitempty" validate:"omitempty,max=255"`
+
+	// Dietary or accessibility notes.
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+
+	// Phone number in E.164 format, e.g. +5511999999999.
+	Phone *string `json:"phone,omitempty" validate:"omitempty,e164"`
 }
 
 // InviteParticipantResponse defines model for InviteParticipantResponse.
@@ -132,386 +407,4739 @@ type InviteParticipantResponse struct {
 	ParticipantID string `json:"participantId"`
 }
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+// OpenGraph metadata of the linked page, present once preview_status is ready.
+type LinkPreview struct {
+	Description *string `json:"description"`
+	ImageURL    *string `json:"image_url"`
+	SiteName    *string `json:"site_name"`
+	Title       *string `json:"title"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// ListTripsResponse defines model for ListTripsResponse.
+type ListTripsResponse struct {
+	// Pass as cursor to get the next page, null on the last one.
+	NextCursor *string       `json:"next_cursor"`
+	Trips      []TripSummary `json:"trips"`
+}
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+// LoginPreview defines model for LoginPreview.
+type LoginPreview struct {
+	// E-mail the link signs in.
+	Email     openapi_types.Email `json:"email"`
+	ExpiresAt time.Time           `json:"expires_at"`
+}
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+// OwnershipTransferPreview defines model for OwnershipTransferPreview.
+type OwnershipTransferPreview struct {
+	Destination string `json:"destination"`
+
+	// E-mail of the nominated participant, the one to sign in with.
+	Email         openapi_types.Email `json:"email"`
+	EndsAt        time.Time           `json:"ends_at"`
+	OwnerName     string              `json:"owner_name"`
+	ParticipantID string              `json:"participant_id"`
+	StartsAt      time.Time           `json:"starts_at"`
+	Timezone      string              `json:"timezone"`
+	TripID        string              `json:"trip_id"`
+}
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+// PatchActivityRequest defines model for PatchActivityRequest.
+type PatchActivityRequest struct {
+	OccursAt *time.Time `json:"occurs_at,omitempty"`
+	Title    *string    `json:"title,omitempty" validate:"omitempty,min=1"`
+}
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+// RsvpCounts defines model for RsvpCounts.
+type RsvpCounts struct {
+	Accepted int `json:"accepted"`
+	Declined int `json:"declined"`
+	Maybe    int `json:"maybe"`
+	Pending  int `json:"pending"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// RsvpRequest defines model for RsvpRequest.
+type RsvpRequest struct {
+	// Optional message to the trip owner.
+	Comment *string `json:"comment,omitempty" validate:"omitempty,max=1000"`
 }
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+// SessionResponse defines model for SessionResponse.
+type SessionResponse struct {
+	ExpiresAt time.Time `json:"expires_at"`
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Bearer token to send in the Authorization header.
+	Token string `json:"token"`
+	User  User   `json:"user"`
 }
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+// TransferOwnershipRequest defines model for TransferOwnershipRequest.
+type TransferOwnershipRequest struct {
+	// Participant to hand the trip over to.
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// TripConfirmationPreview defines model for TripConfirmationPreview.
+type TripConfirmationPreview struct {
+	Destination string     `json:"destination"`
+	EndsAt      time.Time  `json:"ends_at"`
+	OwnerName   string     `json:"owner_name"`
+	StartsAt    time.Time  `json:"starts_at"`
+	Status      TripStatus `json:"status"`
+	Timezone    string     `json:"timezone"`
+	TripID      string     `json:"trip_id"`
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// TripStatusTransition defines model for TripStatusTransition.
+type TripStatusTransition struct {
+	At time.Time `json:"at"`
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Null for the transition that created the trip.
+	From *string    `json:"from"`
+	To   TripStatus `json:"to"`
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// TripSummary defines model for TripSummary.
+type TripSummary struct {
+	Destination string    `json:"destination"`
+	EndsAt      time.Time `json:"ends_at"`
+	ID          string    `json:"id"`
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Whether the signed in user owns the trip rather than being invited to it.
+	IsOwner   bool       `json:"is_owner"`
+	OwnerName string     `json:"owner_name"`
+	StartsAt  time.Time  `json:"starts_at"`
+	Status    TripStatus `json:"status"`
+	Timezone  string     `json:"timezone"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// UpdateActivityRequest defines model for UpdateActivityRequest.
+type UpdateActivityRequest struct {
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// Replaces every editable field of a link, omitted optional fields are cleared.
+type UpdateLinkRequest struct {
+	Category    *LinkCategory `json:"category,omitempty"`
+	Description *string       `json:"description,omitempty" validate:"omitempty,max=1000"`
+
+	// Links are listed by ascending sort_order, then by creation. Defaults to 0.
+	SortOrder *int32 `json:"sort_order,omitempty"`
+	Title     string `json:"title" validate:"required,max=255"`
+	URL       string `json:"url" validate:"required,url,max=255"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// UpdateParticipantRoleRequest defines model for UpdateParticipantRoleRequest.
+type UpdateParticipantRoleRequest struct {
+	// What a participant may do on the trip. co_organiser: everything the owner can but deleting or cancelling the trip. member: add and edit activities, links and files. viewer: read only.
+	Role ParticipantRole `json:"role"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	Destination string `json:"destination" validate:"required,min=4"`
+
+	// Must be after starts_at.
+	EndsAt time.Time `json:"ends_at" validate:"required"`
+
+	// Can't be in the past.
+	StartsAt time.Time `json:"starts_at" validate:"required,notpast"`
+
+	// IANA time zone the trip happens in. Left unchanged when omitted.
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// User defines model for User.
+type User struct {
+	CreatedAt time.Time           `json:"created_at"`
+	Email     openapi_types.Email `json:"email"`
+	ID        string              `json:"id"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// BulkInviteResultStatus defines model for BulkInviteResult.Status.
+type BulkInviteResultStatus struct {
+	value string
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+func (t *BulkInviteResultStatus) ToValue() string {
+	return t.value
+}
+func (t BulkInviteResultStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *BulkInviteResultStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *BulkInviteResultStatus) FromValue(value string) error {
+	switch value {
+
+	case BulkInviteResultStatusCreated.value:
+		t.value = value
+		return nil
+
+	case BulkInviteResultStatusDuplicate.value:
+		t.value = value
+		return nil
+
+	case BulkInviteResultStatusInvalid.value:
+		t.value = value
+		return nil
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// Machine-readable reason of an error. invalid_request: malformed body or parameters (400). invalid_token: missing, expired or used token (400). validation_failed: well-formed but invalid input, see details (422). not_found: 404. trip_closed, invalid_transition, already_answered, duplicate_participant, conflict: the request clashes with the current state (409). unauthorized: missing, invalid or expired session, or a sign-in the identity provider refused (401). forbidden: the caller's role on the trip does not allow it (403). internal: 500.
+type ErrorCode struct {
+	value string
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+func (t *ErrorCode) ToValue() string {
+	return t.value
+}
+func (t ErrorCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ErrorCode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ErrorCode) FromValue(value string) error {
+	switch value {
+
+	case ErrorCodeAlreadyAnswered.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeConflict.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeDuplicateParticipant.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeForbidden.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInternal.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInvalidRequest.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInvalidToken.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInvalidTransition.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeNotFound.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeTripClosed.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeUnauthorized.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeValidationFailed.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// LinkCategory defines model for LinkCategory.
+type LinkCategory struct {
+	value string
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+func (t *LinkCategory) ToValue() string {
+	return t.value
+}
+func (t LinkCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *LinkCategory) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *LinkCategory) FromValue(value string) error {
+	switch value {
+
+	case LinkCategoryBooking.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryDocument.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryMap.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryTicket.value:
+		t.value = value
+		return nil
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// pending: the page was not fetched yet. ready: preview holds what the page says about itself. failed: the page could not be fetched.
+type LinkPreviewStatus struct {
+	value string
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+func (t *LinkPreviewStatus) ToValue() string {
+	return t.value
+}
+func (t LinkPreviewStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *LinkPreviewStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *LinkPreviewStatus) FromValue(value string) error {
+	switch value {
+
+	case LinkPreviewStatusFailed.value:
+		t.value = value
+		return nil
+
+	case LinkPreviewStatusPending.value:
+		t.value = value
+		return nil
+
+	case LinkPreviewStatusReady.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// What a participant may do on the trip. co_organiser: everything the owner can but deleting or cancelling the trip. member: add and edit activities, links and files. viewer: read only.
+type ParticipantRole struct {
+	value string
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+func (t *ParticipantRole) ToValue() string {
+	return t.value
+}
+func (t ParticipantRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ParticipantRole) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ParticipantRole) FromValue(value string) error {
+	switch value {
+
+	case ParticipantRoleCoOrganiser.value:
+		t.value = value
+		return nil
+
+	case ParticipantRoleMember.value:
+		t.value = value
+		return nil
+
+	case ParticipantRoleViewer.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body InviteParticipantResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// RsvpStatus defines model for RsvpStatus.
+type RsvpStatus struct {
+	value string
 }
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+func (t *RsvpStatus) ToValue() string {
+	return t.value
+}
+func (t RsvpStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *RsvpStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *RsvpStatus) FromValue(value string) error {
+	switch value {
+
+	case RsvpStatusAccepted.value:
+		t.value = value
+		return nil
+
+	case RsvpStatusDeclined.value:
+		t.value = value
+		return nil
+
+	case RsvpStatusMaybe.value:
+		t.value = value
+		return nil
+
+	case RsvpStatusPending.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// TripStatus defines model for TripStatus.
+type TripStatus struct {
+	value string
+}
+
+func (t *TripStatus) ToValue() string {
+	return t.value
+}
+func (t TripStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TripStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *TripStatus) FromValue(value string) error {
+	switch value {
+
+	case TripStatusArchived.value:
+		t.value = value
+		return nil
+
+	case TripStatusCancelled.value:
+		t.value = value
+		return nil
+
+	case TripStatusCompleted.value:
+		t.value = value
+		return nil
+
+	case TripStatusConfirmed.value:
+		t.value = value
+		return nil
+
+	case TripStatusDraft.value:
+		t.value = value
+		return nil
+
+	case TripStatusInProgress.value:
+		t.value = value
+		return nil
+
+	case TripStatusPendingConfirmation.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PostAuthLoginJSONBody defines parameters for PostAuthLogin.
+type PostAuthLoginJSONBody LoginRequest
+
+// GetAuthLoginLoginIDParams defines parameters for GetAuthLoginLoginID.
+type GetAuthLoginLoginIDParams struct {
+	// Token from the sign-in e-mail.
+	Token string `json:"token"`
+}
+
+// PostAuthLoginLoginIDParams defines parameters for PostAuthLoginLoginID.
+type PostAuthLoginLoginIDParams struct {
+	// Token from the sign-in e-mail.
+	Token string `json:"token"`
+}
+
+// GetAuthOidcCallbackParams defines parameters for GetAuthOidcCallback.
+type GetAuthOidcCallbackParams struct {
+	// Authorization code issued by the provider.
+	Code *string `json:"code,omitempty"`
+
+	// State the sign-in was started with.
+	State *string `json:"state,omitempty"`
+
+	// Why the provider refused the sign-in, instead of a code.
+	Error *string `json:"error,omitempty"`
+}
+
+// GetParticipantsParticipantIDAcceptOwnershipParams defines parameters for GetParticipantsParticipantIDAcceptOwnership.
+type GetParticipantsParticipantIDAcceptOwnershipParams struct {
+	// Ownership transfer token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipParams defines parameters for PostParticipantsParticipantIDAcceptOwnership.
+type PostParticipantsParticipantIDAcceptOwnershipParams struct {
+	// Ownership transfer token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// GetParticipantsParticipantIDConfirmParams defines parameters for GetParticipantsParticipantIDConfirm.
+type GetParticipantsParticipantIDConfirmParams struct {
+	// Confirmation token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// PatchParticipantsParticipantIDConfirmJSONBody defines parameters for PatchParticipantsParticipantIDConfirm.
+type PatchParticipantsParticipantIDConfirmJSONBody ConfirmParticipantRequest
+
+// PatchParticipantsParticipantIDConfirmParams defines parameters for PatchParticipantsParticipantIDConfirm.
+type PatchParticipantsParticipantIDConfirmParams struct {
+	// Confirmation token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// GetParticipantsParticipantIDDeclineParams defines parameters for GetParticipantsParticipantIDDecline.
+type GetParticipantsParticipantIDDeclineParams struct {
+	// Confirmation token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// PatchParticipantsParticipantIDDeclineJSONBody defines parameters for PatchParticipantsParticipantIDDecline.
+type PatchParticipantsParticipantIDDeclineJSONBody RsvpRequest
+
+// PatchParticipantsParticipantIDDeclineParams defines parameters for PatchParticipantsParticipantIDDecline.
+type PatchParticipantsParticipantIDDeclineParams struct {
+	// Confirmation token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// GetParticipantsParticipantIDMaybeParams defines parameters for GetParticipantsParticipantIDMaybe.
+type GetParticipantsParticipantIDMaybeParams struct {
+	// Confirmation token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// PatchParticipantsParticipantIDMaybeJSONBody defines parameters for PatchParticipantsParticipantIDMaybe.
+type PatchParticipantsParticipantIDMaybeJSONBody RsvpRequest
+
+// PatchParticipantsParticipantIDMaybeParams defines parameters for PatchParticipantsParticipantIDMaybe.
+type PatchParticipantsParticipantIDMaybeParams struct {
+	// Confirmation token sent to the participant by e-mail.
+	Token string `json:"token"`
+}
+
+// GetTripsParams defines parameters for GetTrips.
+type GetTripsParams struct {
+	// upcoming: trips that have not ended yet. past: trips that have ended. Defaults to both.
+	When *GetTripsParamsWhen `json:"when,omitempty"`
+
+	// Only trips in this status.
+	Status *GetTripsParamsStatus `json:"status,omitempty"`
+
+	// Only trips whose destination contains this text, ignoring case.
+	Destination *string `json:"destination,omitempty"`
+
+	// starts_at lists the trips starting first first, -starts_at the other way around. Defaults to starts_at.
+	Sort *GetTripsParamsSort `json:"sort,omitempty"`
+
+	// Maximum number of trips returned. Defaults to 20.
+	Limit *int `json:"limit,omitempty"`
+
+	// next_cursor of the previous page, with the same filters and sort. A cursor from the other sort order is rejected.
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// GetTripsParamsWhen defines parameters for GetTrips.
+type GetTripsParamsWhen string
+
+// GetTripsParamsStatus defines parameters for GetTrips.
+type GetTripsParamsStatus string
+
+// GetTripsParamsSort defines parameters for GetTrips.
+type GetTripsParamsSort string
+
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// PatchTripsTripIDActivitiesActivityIDJSONBody defines parameters for PatchTripsTripIDActivitiesActivityID.
+type PatchTripsTripIDActivitiesActivityIDJSONBody PatchActivityRequest
+
+// PutTripsTripIDActivitiesActivityIDJSONBody defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDJSONBody UpdateActivityRequest
+
+// GetTripsTripIDAttachmentsParams defines parameters for GetTripsTripIDAttachments.
+type GetTripsTripIDAttachmentsParams struct {
+	// Only list the files of this activity.
+	ActivityID *string `json:"activity_id,omitempty"`
+}
+
+// PostTripsTripIDCancelJSONBody defines parameters for PostTripsTripIDCancel.
+type PostTripsTripIDCancelJSONBody CancelTripRequest
+
+// GetTripsTripIDConfirmParams defines parameters for GetTripsTripIDConfirm.
+type GetTripsTripIDConfirmParams struct {
+	// Confirmation token sent to the trip owner by e-mail.
+	Token string `json:"token"`
+}
+
+// PatchTripsTripIDConfirmParams defines parameters for PatchTripsTripIDConfirm.
+type PatchTripsTripIDConfirmParams struct {
+	// Confirmation token sent to the trip owner by e-mail.
+	Token string `json:"token"`
+}
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+
+// PostTripsTripIDInvitesBulkJSONBody defines parameters for PostTripsTripIDInvitesBulk.
+type PostTripsTripIDInvitesBulkJSONBody BulkInviteRequest
+
+// DeleteTripsTripIDInvitesParticipantIDParams defines parameters for DeleteTripsTripIDInvitesParticipantID.
+type DeleteTripsTripIDInvitesParticipantIDParams struct {
+	// Send the person a courtesy e-mail. Defaults to false.
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PutTripsTripIDLinksLinkIDJSONBody defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDJSONBody UpdateLinkRequest
+
+// PostTripsTripIDOwnershipTransferJSONBody defines parameters for PostTripsTripIDOwnershipTransfer.
+type PostTripsTripIDOwnershipTransferJSONBody TransferOwnershipRequest
+
+// DeleteTripsTripIDParticipantsParticipantIDParams defines parameters for DeleteTripsTripIDParticipantsParticipantID.
+type DeleteTripsTripIDParticipantsParticipantIDParams struct {
+	// Send the person a courtesy e-mail. Defaults to false.
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSONBody defines parameters for PutTripsTripIDParticipantsParticipantIDRole.
+type PutTripsTripIDParticipantsParticipantIDRoleJSONBody UpdateParticipantRoleRequest
+
+// PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
+type PostAuthLoginJSONRequestBody PostAuthLoginJSONBody
+
+// Bind implements render.Binder.
+func (PostAuthLoginJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDConfirmJSONRequestBody defines body for PatchParticipantsParticipantIDConfirm for application/json ContentType.
+type PatchParticipantsParticipantIDConfirmJSONRequestBody PatchParticipantsParticipantIDConfirmJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDConfirmJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDDeclineJSONRequestBody defines body for PatchParticipantsParticipantIDDecline for application/json ContentType.
+type PatchParticipantsParticipantIDDeclineJSONRequestBody PatchParticipantsParticipantIDDeclineJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDDeclineJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDMaybeJSONRequestBody defines body for PatchParticipantsParticipantIDMaybe for application/json ContentType.
+type PatchParticipantsParticipantIDMaybeJSONRequestBody PatchParticipantsParticipantIDMaybeJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDMaybeJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PatchTripsTripIDActivitiesActivityID for application/json ContentType.
+type PatchTripsTripIDActivitiesActivityIDJSONRequestBody PatchTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PutTripsTripIDActivitiesActivityID for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDJSONRequestBody PutTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDCancelJSONRequestBody defines body for PostTripsTripIDCancel for application/json ContentType.
+type PostTripsTripIDCancelJSONRequestBody PostTripsTripIDCancelJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDCancelJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesBulkJSONRequestBody defines body for PostTripsTripIDInvitesBulk for application/json ContentType.
+type PostTripsTripIDInvitesBulkJSONRequestBody PostTripsTripIDInvitesBulkJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesBulkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLinksLinkIDJSONRequestBody defines body for PutTripsTripIDLinksLinkID for application/json ContentType.
+type PutTripsTripIDLinksLinkIDJSONRequestBody PutTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDOwnershipTransferJSONRequestBody defines body for PostTripsTripIDOwnershipTransfer for application/json ContentType.
+type PostTripsTripIDOwnershipTransferJSONRequestBody PostTripsTripIDOwnershipTransferJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDOwnershipTransferJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSONRequestBody defines body for PutTripsTripIDParticipantsParticipantIDRole for application/json ContentType.
+type PutTripsTripIDParticipantsParticipantIDRoleJSONRequestBody PutTripsTripIDParticipantsParticipantIDRoleJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDParticipantsParticipantIDRoleJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// PostAuthLoginJSON202Response is a constructor method for a PostAuthLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginJSON202Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLoginJSON400Response is a constructor method for a PostAuthLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLoginJSON422Response is a constructor method for a PostAuthLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLoginJSON500Response is a constructor method for a PostAuthLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthLoginLoginIDJSON200Response is a constructor method for a GetAuthLoginLoginID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthLoginLoginIDJSON200Response(body LoginPreview) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthLoginLoginIDJSON400Response is a constructor method for a GetAuthLoginLoginID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthLoginLoginIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthLoginLoginIDJSON500Response is a constructor method for a GetAuthLoginLoginID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthLoginLoginIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLoginLoginIDJSON200Response is a constructor method for a PostAuthLoginLoginID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginLoginIDJSON200Response(body SessionResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLoginLoginIDJSON400Response is a constructor method for a PostAuthLoginLoginID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginLoginIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLoginLoginIDJSON500Response is a constructor method for a PostAuthLoginLoginID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLoginLoginIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostAuthLogoutJSON204Response is a constructor method for a PostAuthLogout response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAuthLogoutJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthMeJSON200Response is a constructor method for a GetAuthMe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthMeJSON200Response(body User) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthMeJSON401Response is a constructor method for a GetAuthMe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthMeJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthMeJSON500Response is a constructor method for a GetAuthMe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthMeJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcCallbackJSON200Response is a constructor method for a GetAuthOidcCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcCallbackJSON200Response(body SessionResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcCallbackJSON400Response is a constructor method for a GetAuthOidcCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcCallbackJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcCallbackJSON401Response is a constructor method for a GetAuthOidcCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcCallbackJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcCallbackJSON404Response is a constructor method for a GetAuthOidcCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcCallbackJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcCallbackJSON500Response is a constructor method for a GetAuthOidcCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcCallbackJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcLoginJSON404Response is a constructor method for a GetAuthOidcLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcLoginJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetAuthOidcLoginJSON500Response is a constructor method for a GetAuthOidcLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAuthOidcLoginJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDAcceptOwnershipJSON200Response is a constructor method for a GetParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDAcceptOwnershipJSON200Response(body OwnershipTransferPreview) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDAcceptOwnershipJSON400Response is a constructor method for a GetParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDAcceptOwnershipJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDAcceptOwnershipJSON404Response is a constructor method for a GetParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDAcceptOwnershipJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDAcceptOwnershipJSON409Response is a constructor method for a GetParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDAcceptOwnershipJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDAcceptOwnershipJSON500Response is a constructor method for a GetParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDAcceptOwnershipJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON204Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON400Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON401Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON403Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON404Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON409Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDAcceptOwnershipJSON500Response is a constructor method for a PostParticipantsParticipantIDAcceptOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDAcceptOwnershipJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON200Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON200Response(body InvitationPreview) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON400Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON404Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON500Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON404Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON409Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON422Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON500Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDDeclineJSON200Response is a constructor method for a GetParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDDeclineJSON200Response(body InvitationPreview) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDDeclineJSON400Response is a constructor method for a GetParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDDeclineJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDDeclineJSON404Response is a constructor method for a GetParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDDeclineJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDDeclineJSON500Response is a constructor method for a GetParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDDeclineJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON204Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON400Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON404Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON409Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON422Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON500Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDMaybeJSON200Response is a constructor method for a GetParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDMaybeJSON200Response(body InvitationPreview) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDMaybeJSON400Response is a constructor method for a GetParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDMaybeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDMaybeJSON404Response is a constructor method for a GetParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDMaybeJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDMaybeJSON500Response is a constructor method for a GetParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDMaybeJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDMaybeJSON204Response is a constructor method for a PatchParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDMaybeJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDMaybeJSON400Response is a constructor method for a PatchParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDMaybeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDMaybeJSON404Response is a constructor method for a PatchParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDMaybeJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDMaybeJSON409Response is a constructor method for a PatchParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDMaybeJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDMaybeJSON422Response is a constructor method for a PatchParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDMaybeJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDMaybeJSON500Response is a constructor method for a PatchParticipantsParticipantIDMaybe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDMaybeJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON200Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON200Response(body ListTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON400Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON401Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON500Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON422Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON500Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON204Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON400Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON401Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON403Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON404Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON409Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON500Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON401Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON403Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON404Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON500Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON401Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON403Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON404Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON409Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON422Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON500Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON401Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON403Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON404Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON500Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON401Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON403Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON404Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON422Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON500Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON422Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON422Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON204Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON400Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON401Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON403Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON404Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON409Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDArchiveJSON500Response is a constructor method for a PostTripsTripIDArchive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDArchiveJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsJSON200Response is a constructor method for a GetTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsJSON200Response(body GetAttachmentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsJSON400Response is a constructor method for a GetTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsJSON401Response is a constructor method for a GetTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsJSON403Response is a constructor method for a GetTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsJSON404Response is a constructor method for a GetTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsJSON500Response is a constructor method for a GetTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON201Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON201Response(body CreateAttachmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON400Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON401Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON403Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON404Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON422Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsJSON500Response is a constructor method for a PostTripsTripIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentIDJSON204Response is a constructor method for a DeleteTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDAttachmentsAttachmentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentIDJSON400Response is a constructor method for a DeleteTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDAttachmentsAttachmentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentIDJSON401Response is a constructor method for a DeleteTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDAttachmentsAttachmentIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentIDJSON403Response is a constructor method for a DeleteTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDAttachmentsAttachmentIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentIDJSON404Response is a constructor method for a DeleteTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDAttachmentsAttachmentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentIDJSON500Response is a constructor method for a DeleteTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDAttachmentsAttachmentIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON400Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON401Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON403Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON404Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON500Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON204Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON400Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON401Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON403Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON404Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON409Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON422Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCancelJSON500Response is a constructor method for a PostTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCancelJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON200Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON200Response(body TripConfirmationPreview) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON404Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON409Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON500Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON204Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON400Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON404Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON409Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON500Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body InviteParticipantResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON401Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON403Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON404Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON409Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON409Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON422Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON500Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON200Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON200Response(body BulkInviteResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON400Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON401Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON403Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON404Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON409Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON422Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON500Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON401Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON409Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDInvitesParticipantIDJSON500Response is a constructor method for a DeleteTripsTripIDInvitesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDInvitesParticipantIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON401Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON403Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON404Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON500Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON401Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON403Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON404Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON422Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON500Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON400Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON401Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON403Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON404Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON500Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON401Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON404Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON422Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON500Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnershipTransferJSON204Response is a constructor method for a DeleteTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnershipTransferJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnershipTransferJSON400Response is a constructor method for a DeleteTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnershipTransferJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnershipTransferJSON401Response is a constructor method for a DeleteTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnershipTransferJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnershipTransferJSON403Response is a constructor method for a DeleteTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnershipTransferJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnershipTransferJSON404Response is a constructor method for a DeleteTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnershipTransferJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnershipTransferJSON500Response is a constructor method for a DeleteTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnershipTransferJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON202Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON202Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON400Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON401Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON403Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON404Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON409Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON422Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnershipTransferJSON500Response is a constructor method for a PostTripsTripIDOwnershipTransfer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnershipTransferJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON401Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON403Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON404Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON500Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON401Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON500Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON204Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON400Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON401Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON403Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON404Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON422Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDRoleJSON500Response is a constructor method for a PutTripsTripIDParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDRoleJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Send a sign-in link by e-mail.
+	// (POST /auth/login)
+	PostAuthLogin(w http.ResponseWriter, r *http.Request) *Response
+	// Shows the sign-in a link from the e-mail is for.
+	// (GET /auth/login/{loginId})
+	GetAuthLoginLoginID(w http.ResponseWriter, r *http.Request, loginID string, params GetAuthLoginLoginIDParams) *Response
+	// Sign in with the link sent by e-mail.
+	// (POST /auth/login/{loginId})
+	PostAuthLoginLoginID(w http.ResponseWriter, r *http.Request, loginID string, params PostAuthLoginLoginIDParams) *Response
+	// Sign out of the session cookie.
+	// (POST /auth/logout)
+	PostAuthLogout(w http.ResponseWriter, r *http.Request) *Response
+	// Get the signed in user.
+	// (GET /auth/me)
+	GetAuthMe(w http.ResponseWriter, r *http.Request) *Response
+	// Finish signing in with the identity provider.
+	// (GET /auth/oidc/callback)
+	GetAuthOidcCallback(w http.ResponseWriter, r *http.Request, params GetAuthOidcCallbackParams) *Response
+	// Sign in with the identity provider.
+	// (GET /auth/oidc/login)
+	GetAuthOidcLogin(w http.ResponseWriter, r *http.Request) *Response
+	// Shows the hand-over an ownership transfer link from the e-mail accepts.
+	// (GET /participants/{participantId}/accept-ownership)
+	GetParticipantsParticipantIDAcceptOwnership(w http.ResponseWriter, r *http.Request, participantID string, params GetParticipantsParticipantIDAcceptOwnershipParams) *Response
+	// Take over a trip from the ownership transfer e-mail link.
+	// (POST /participants/{participantId}/accept-ownership)
+	PostParticipantsParticipantIDAcceptOwnership(w http.ResponseWriter, r *http.Request, participantID string, params PostParticipantsParticipantIDAcceptOwnershipParams) *Response
+	// Shows the invitation a confirm link from the e-mail answers.
+	// (GET /participants/{participantId}/confirm)
+	GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params GetParticipantsParticipantIDConfirmParams) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params PatchParticipantsParticipantIDConfirmParams) *Response
+	// Shows the invitation a decline link from the e-mail answers.
+	// (GET /participants/{participantId}/decline)
+	GetParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string, params GetParticipantsParticipantIDDeclineParams) *Response
+	// Declines a trip invitation.
+	// (PATCH /participants/{participantId}/decline)
+	PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string, params PatchParticipantsParticipantIDDeclineParams) *Response
+	// Shows the invitation a maybe link from the e-mail answers.
+	// (GET /participants/{participantId}/maybe)
+	GetParticipantsParticipantIDMaybe(w http.ResponseWriter, r *http.Request, participantID string, params GetParticipantsParticipantIDMaybeParams) *Response
+	// Answers maybe to a trip invitation.
+	// (PATCH /participants/{participantId}/maybe)
+	PatchParticipantsParticipantIDMaybe(w http.ResponseWriter, r *http.Request, participantID string, params PatchParticipantsParticipantIDMaybeParams) *Response
+	// List the trips of the signed in user.
+	// (GET /trips)
+	GetTrips(w http.ResponseWriter, r *http.Request, params GetTripsParams) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request) *Response
+	// Delete a trip that was never confirmed.
+	// (DELETE /trips/{tripId})
+	DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Update some fields of a trip activity.
+	// (PATCH /trips/{tripId}/activities/{activityId})
+	PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Replace a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId})
+	PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Archive a completed or cancelled trip.
+	// (POST /trips/{tripId}/archive)
+	PostTripsTripIDArchive(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the files attached to a trip.
+	// (GET /trips/{tripId}/attachments)
+	GetTripsTripIDAttachments(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDAttachmentsParams) *Response
+	// Attach a file to a trip.
+	// (POST /trips/{tripId}/attachments)
+	PostTripsTripIDAttachments(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a file attached to a trip.
+	// (DELETE /trips/{tripId}/attachments/{attachmentId})
+	DeleteTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *Response
+	// Download a file attached to a trip.
+	// (GET /trips/{tripId}/attachments/{attachmentId})
+	GetTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *Response
+	// Cancel a confirmed trip and notify its participants.
+	// (POST /trips/{tripId}/cancel)
+	PostTripsTripIDCancel(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Shows the trip a confirmation link from the e-mail confirms.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDConfirmParams) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (PATCH /trips/{tripId}/confirm)
+	PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params PatchTripsTripIDConfirmParams) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Invite many people to the trip at once.
+	// (POST /trips/{tripId}/invites/bulk)
+	PostTripsTripIDInvitesBulk(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Revoke a pending invitation.
+	// (DELETE /trips/{tripId}/invites/{participantId})
+	DeleteTripsTripIDInvitesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params DeleteTripsTripIDInvitesParticipantIDParams) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip link.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Replace a trip link.
+	// (PUT /trips/{tripId}/links/{linkId})
+	PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Withdraw the pending hand-over of a trip.
+	// (DELETE /trips/{tripId}/ownership-transfer)
+	DeleteTripsTripIDOwnershipTransfer(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Hand a trip over to one of its participants.
+	// (POST /trips/{tripId}/ownership-transfer)
+	PostTripsTripIDOwnershipTransfer(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a participant from a trip.
+	// (DELETE /trips/{tripId}/participants/{participantId})
+	DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params DeleteTripsTripIDParticipantsParticipantIDParams) *Response
+	// Change the role of a participant on a trip.
+	// (PUT /trips/{tripId}/participants/{participantId}/role)
+	PutTripsTripIDParticipantsParticipantIDRole(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	Middlewares      Middlewares
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAuthLogin(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAuthLoginLoginID operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthLoginLoginID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "loginId" -------------
+	var loginID string
+
+	if err := runtime.BindStyledParameter("simple", false, "loginId", chi.URLParam(r, "loginId"), &loginID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "loginId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthLoginLoginIDParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAuthLoginLoginID(w, r, loginID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAuthLoginLoginID operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLoginLoginID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "loginId" -------------
+	var loginID string
+
+	if err := runtime.BindStyledParameter("simple", false, "loginId", chi.URLParam(r, "loginId"), &loginID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "loginId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthLoginLoginIDParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAuthLoginLoginID(w, r, loginID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAuthLogout(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAuthMe operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAuthMe(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.User(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAuthOidcCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthOidcCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthOidcCallbackParams
+
+	// ------------- Optional query parameter "code" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code); err != nil {
+		err = fmt.Errorf("invalid format for parameter code: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "code"})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State); err != nil {
+		err = fmt.Errorf("invalid format for parameter state: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "state"})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error); err != nil {
+		err = fmt.Errorf("invalid format for parameter error: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "error"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAuthOidcCallback(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAuthOidcLogin operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthOidcLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAuthOidcLogin(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDAcceptOwnership operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDAcceptOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsParticipantIDAcceptOwnershipParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDAcceptOwnership(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDAcceptOwnership operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDAcceptOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostParticipantsParticipantIDAcceptOwnershipParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDAcceptOwnership(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.User(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsParticipantIDConfirmParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDConfirm(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchParticipantsParticipantIDConfirmParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDDecline operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsParticipantIDDeclineParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDDecline(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDDecline operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchParticipantsParticipantIDDeclineParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDDecline(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDMaybe operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDMaybe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsParticipantIDMaybeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDMaybe(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDMaybe operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDMaybe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchParticipantsParticipantIDMaybeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDMaybe(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsParams
+
+	// ------------- Optional query parameter "when" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "when", r.URL.Query(), &params.When); err != nil {
+		err = fmt.Errorf("invalid format for parameter when: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "when"})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status); err != nil {
+		err = fmt.Errorf("invalid format for parameter status: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	// ------------- Optional query parameter "destination" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "destination", r.URL.Query(), &params.Destination); err != nil {
+		err = fmt.Errorf("invalid format for parameter destination: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "destination"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.User(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Owner(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Viewer(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Viewer(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDArchive(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDAttachmentsParams
+
+	// ------------- Optional query parameter "activity_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "activity_id", r.URL.Query(), &params.ActivityID); err != nil {
+		err = fmt.Errorf("invalid format for parameter activity_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activity_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDAttachments(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Viewer(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDAttachments(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDAttachmentsAttachmentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "attachmentId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDAttachmentsAttachmentID(w, r, tripID, attachmentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDAttachmentsAttachmentID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "attachmentId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDAttachmentsAttachmentID(w, r, tripID, attachmentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Viewer(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDCancel(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Owner(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDConfirmParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// PatchTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDConfirmParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDConfirm(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Public(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDInvitesBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvitesBulk(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.PostTripsTripIDInvitesBulk(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -521,15 +5149,45 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDInvitesParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDInvitesParticipantID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDInvitesParticipantIDParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "notify", r.URL.Query(), &params.Notify); err != nil {
+		err = fmt.Errorf("invalid format for parameter notify: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notify"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.DeleteTripsTripIDInvitesParticipantID(w, r, tripID, participantID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -539,11 +5197,14 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -555,7 +5216,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -565,11 +5226,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Viewer(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -581,7 +5245,7 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -591,11 +5255,14 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -606,8 +5273,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -617,11 +5292,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -632,8 +5310,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -643,11 +5329,14 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Member(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDOwnershipTransfer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -659,7 +5348,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDOwnershipTransfer(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -669,11 +5358,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Owner(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDOwnershipTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -685,7 +5377,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDOwnershipTransfer(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -695,11 +5387,14 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Owner(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -711,7 +5406,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -721,11 +5416,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Viewer(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -736,8 +5434,27 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDParticipantsParticipantIDParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "notify", r.URL.Query(), &params.Notify); err != nil {
+		err = fmt.Errorf("invalid format for parameter notify: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notify"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDParticipantsParticipantID(w, r, tripID, participantID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -747,11 +5464,14 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDParticipantsParticipantIDRole operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDParticipantsParticipantIDRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -762,8 +5482,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDParticipantsParticipantIDRole(w, r, tripID, participantID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -773,6 +5501,9 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Organiser(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
@@ -862,9 +5593,20 @@ func (err RequiredHeaderError) ParamName() string        { return err.paramName
 func (err InvalidParamFormatError) ParamName() string    { return err.paramName }
 func (err TooManyValuesForParamError) ParamName() string { return err.paramName }
 
+// Middlewares holds the set of middleware for this service
+type Middlewares struct {
+	Member    func(http.Handler) http.Handler
+	Organiser func(http.Handler) http.Handler
+	Owner     func(http.Handler) http.Handler
+	Public    func(http.Handler) http.Handler
+	User      func(http.Handler) http.Handler
+	Viewer    func(http.Handler) http.Handler
+}
+
 type ServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
+	Middlewares      Middlewares
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
@@ -873,8 +5615,9 @@ type ServerOption func(*ServerOptions)
 // Handler creates http.Handler with routing matching OpenAPI spec.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	options := &ServerOptions{
-		BaseURL:    "/",
-		BaseRouter: chi.NewRouter(),
+		BaseURL:     "/",
+		BaseRouter:  chi.NewRouter(),
+		Middlewares: Middlewares{},
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		},
@@ -887,21 +5630,76 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	r := options.BaseRouter
 	wrapper := ServerInterfaceWrapper{
 		Handler:          si,
+		Middlewares:      options.Middlewares,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
 	}
 
+	// Operation specific middleware
+	if options.Middlewares.Member == nil {
+		panic("goapi-gen: could not find tagged middleware member (Member)")
+	}
+	if options.Middlewares.Organiser == nil {
+		panic("goapi-gen: could not find tagged middleware organiser (Organiser)")
+	}
+	if options.Middlewares.Owner == nil {
+		panic("goapi-gen: could not find tagged middleware owner (Owner)")
+	}
+	if options.Middlewares.Public == nil {
+		panic("goapi-gen: could not find tagged middleware public (Public)")
+	}
+	if options.Middlewares.User == nil {
+		panic("goapi-gen: could not find tagged middleware user (User)")
+	}
+	if options.Middlewares.Viewer == nil {
+		panic("goapi-gen: could not find tagged middleware viewer (Viewer)")
+	}
+
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Post("/auth/login", wrapper.PostAuthLogin)
+		r.Get("/auth/login/{loginId}", wrapper.GetAuthLoginLoginID)
+		r.Post("/auth/login/{loginId}", wrapper.PostAuthLoginLoginID)
+		r.Post("/auth/logout", wrapper.PostAuthLogout)
+		r.Get("/auth/me", wrapper.GetAuthMe)
+		r.Get("/auth/oidc/callback", wrapper.GetAuthOidcCallback)
+		r.Get("/auth/oidc/login", wrapper.GetAuthOidcLogin)
+		r.Get("/participants/{participantId}/accept-ownership", wrapper.GetParticipantsParticipantIDAcceptOwnership)
+		r.Post("/participants/{participantId}/accept-ownership", wrapper.PostParticipantsParticipantIDAcceptOwnership)
+		r.Get("/participants/{participantId}/confirm", wrapper.GetParticipantsParticipantIDConfirm)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Get("/participants/{participantId}/decline", wrapper.GetParticipantsParticipantIDDecline)
+		r.Patch("/participants/{participantId}/decline", wrapper.PatchParticipantsParticipantIDDecline)
+		r.Get("/participants/{participantId}/maybe", wrapper.GetParticipantsParticipantIDMaybe)
+		r.Patch("/participants/{participantId}/maybe", wrapper.PatchParticipantsParticipantIDMaybe)
+		r.Get("/trips", wrapper.GetTrips)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Delete("/trips/{tripId}", wrapper.DeleteTripsTripID)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}", wrapper.PatchTripsTripIDActivitiesActivityID)
+		r.Put("/trips/{tripId}/activities/{activityId}", wrapper.PutTripsTripIDActivitiesActivityID)
+		r.Post("/trips/{tripId}/archive", wrapper.PostTripsTripIDArchive)
+		r.Get("/trips/{tripId}/attachments", wrapper.GetTripsTripIDAttachments)
+		r.Post("/trips/{tripId}/attachments", wrapper.PostTripsTripIDAttachments)
+		r.Delete("/trips/{tripId}/attachments/{attachmentId}", wrapper.DeleteTripsTripIDAttachmentsAttachmentID)
+		r.Get("/trips/{tripId}/attachments/{attachmentId}", wrapper.GetTripsTripIDAttachmentsAttachmentID)
+		r.Post("/trips/{tripId}/cancel", wrapper.PostTripsTripIDCancel)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Patch("/trips/{tripId}/confirm", wrapper.PatchTripsTripIDConfirm)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
+		r.Post("/trips/{tripId}/invites/bulk", wrapper.PostTripsTripIDInvitesBulk)
+		r.Delete("/trips/{tripId}/invites/{participantId}", wrapper.DeleteTripsTripIDInvitesParticipantID)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Put("/trips/{tripId}/links/{linkId}", wrapper.PutTripsTripIDLinksLinkID)
+		r.Delete("/trips/{tripId}/ownership-transfer", wrapper.DeleteTripsTripIDOwnershipTransfer)
+		r.Post("/trips/{tripId}/ownership-transfer", wrapper.PostTripsTripIDOwnershipTransfer)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Delete("/trips/{tripId}/participants/{participantId}", wrapper.DeleteTripsTripIDParticipantsParticipantID)
+		r.Put("/trips/{tripId}/participants/{participantId}/role", wrapper.PutTripsTripIDParticipantsParticipantIDRole)
 	})
 	return r
 }
@@ -918,6 +5716,48 @@ func WithServerBaseURL(url string) ServerOption {
 	}
 }
 
+func WithMemberMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Member = middleware
+	}
+}
+
+func WithOrganiserMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Organiser = middleware
+	}
+}
+
+func WithOwnerMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Owner = middleware
+	}
+}
+
+func WithPublicMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Public = middleware
+	}
+}
+
+func WithUserMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.User = middleware
+	}
+}
+
+func WithViewerMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Viewer = middleware
+	}
+}
+
+func WithMiddlewares(middlewares Middlewares) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares = middlewares
+	}
+}
+
 func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err error)) ServerOption {
 	return func(s *ServerOptions) {
 		s.ErrorHandlerFunc = handler
@@ -927,31 +5767,130 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RazY7bNhd9FYLft9SMJ+2sBHSRZILARdAM0hRdBMGAlq5tZiRSIa/sGIafpouuuuwT",
-	"5MUKkrJNybJNy+NMPNkktkbk/Tn3Hh6SntNE5oUUIFDTeE51Moac2Y8vFTCE5wnyCcfZO/hcgkbzB5am",
-	"HLkULLtVsgCFHDSNhyzTENHCezSnMklKpe+YHTeUKjefaMoQLpDnQCOKswJoTDUqLkY0ol8uRvICvqBi",
-	"F8hGdpIJy7gZQmOq4HPJFaR0sYgocszAvNB5jkW0/hZ/8LxdTv5x5aAcfIIE6SLayIsupNBwYGJYNbyf",
-	"1jJTljzdSErTTW/sdv/ecHHfDbPj0xrRUmX1uBTvjHVkJtvAynnpLO3LQieEMi7uu6BTjdvu03vFi27I",
-	"pKCRC2beNl9zLt6AGOGYxtedk5tz8cu1DQJyxjN9h/KOiwlHmy+OkOtaDuxbm0lYPWBKsVm4+ZRPIHJz",
-	"Wh9Eeiq2kFMB6s6Z2h9QcABr350BwfJjm0cjU3iaNDRq1S8o3+4aiJayqEVaz+u+ou/UiKh40aURq3Ft",
-	"Pr1SSqq9bqSgE8UL1270BUuJqtq26WIOWrNRC+5Nn5Yvtjn1GtDQlT6Cr3StZ/+vYEhj+r/eeonvVet7",
-	"r2nsuW3bZhu3cZsOct7Nd1gEPATkrct+4KrTDMnZ2LOYvAY0BVyt+Rz0cav+Mt5AoNpNvy0RVBhsntmD",
-	"ousLsTRxEiQPVYc7wN+F6trMQdF7CX48lD0INlCOqCP4sNw1qZ9ZKg8rjRtAswgcQeCBCWgYMo/eDj61",
-	"UvsB/i6nOZnaOli5LKLQHuH6LpFiyFUOqVf3AykzYIJ2kAutvRKiBGqu7Mj+LVPIE14wgV1LpvCmOLSJ",
-	"2syH8WTN6oEBdiGKUDG6qpYO1bHUo6LMMjYw3ImqhKCaqATe0qe98PetPvSS022XczKJ3ohxu2RtCeTY",
-	"Ku4iYOvD2xz9o0i/5w3l6TZz39MWaRMYMwcXQ1ml2NtEvNIFJHzIE/b176//giYpI89v+6RgihFJBiy5",
-	"vwCRmsesyNxrf0ny+5RlmZySRAqNqvz6T8pIWiomEIgkv735k/wqSyVgZga+k8k9oAaGlysRFNNqChrR",
-	"CSjtvHl2eXV5ZYVYAYIVnMb0Z/soogXDsU1SzyfF3rxWk4teRQiu2DEZ2zO/ApTNl6l5emse+4Tpfe7f",
-	"vKzGG4OK5YCgNI0/zCk3/hknljwUN9rBR8kxmlsGQtrsoxnsOtrG+NPVtfkvkQJBuB4qbPZNFL1P2nXH",
-	"en4QZW5qw3Cqgb/OrRb+Ouw3MGRlhmTFI4uIXl9dHWR018rn9rMthv1Nq+2bMs+ZmtGYVpnXhBEvsUQK",
-	"woiRV7Z2bKM010UzT8+84jhOOtZpoC61XSl1hRNofCHT2YMFvHmS1mhcC8QGzM9O4sAS0/PA3TpOGBEw",
-	"tUB7ODtQPYB7c3eIsjCOjKAF6EoRafNP/yaoj6tzmYdt4IfL6ZYtz3mg+xqw6l+SugAuW/CNaFG2NW35",
-	"aFg+PENsSqMghvjxFgKXqBbW384GvfoJR0UMdYPvx1wTJUsEMuVZRhRgqQRhWUZwDMTY1GQAOAUQ9okt",
-	"2pW+IkykpFJY7uWIwMS+KrWZEseyRLJ2xHi+i5rWRytPiKRaDiTPjqfqEC6Lzz+XMny1W2U8KsSnUjfN",
-	"m/dHUTgb19xnpnL8EpttLbAWivN2NgHC55B9zEmo5YfdwKwwFinRZusMFznjGbGXldYVHbiouevNkE2N",
-	"w7xfvX/eXLP10O4b0832M7fzKEfnP9EyBymAoFyJmpCd9LoKV9e4Aaxjb1yfiJypX32fnYqxsPlIV1fl",
-	"odrl20N5Ktni//DsUSRL7Tdf5yhXTOm0lVILWzTvyQJIwz+LfUJbodZLx7OjER/PXevGYvFfAAAA//9K",
-	"ioULuSsAAA==",
+	"H4sIAAAAAAAC/+x9XW/cNvrvVyF0DrBbHHnspM4Ca2AvXKfbddEmRuNuL4pgwJGemeFaIrUk5clskE9z",
+	"LvbqXJ5P0C/2Bx+SEjXSzEjj1yTqRWpbEvnw5fnxeefHKBF5IThwraKzj5FKlpBT/PFca5osc+Da/EbT",
+	"lGkmOM2upChAagYqOpvTTEEcFcGfPkY00eyW6fWUpebXuZA51dFZVJYsjeKIl1lGZxlEZ1qWEEd6XUB0",
+	"FiktGV9En+IoEVwD11P74GPHCxKohnRKdaP9lGo40iyHqKPROctgymne3WI3pa3XFPsPTGdrDarxOuP6",
+	"L6f1+4xrWIA0H5RFJmgK6XS2bnwBOWVZu4dPcSTh3yWTkEZnv0dIRDib4TA25qlBXLPjxoS9rzoVs39B",
+	"og2V35XZzSW/ZRp+gX+XoIYuOMNv7Y8acvzhf0uYR2fR/zqu99ex21zHQX9iZQjI6YdL++Grk5OKQCol",
+	"NdR/OFqII/igJT3SdIGt39KMmeWOzqoJi3P64W+vTk6iT61pdOTtG7oqBFcwcOxuaoNdFSx/WhYZS6ib",
+	"m/ZzxnEg3Q8lqDLTB80qfmnaaMzk5rz4Dqr9ETUorsnbO3Gmt2HTZhmgixVBSiHNk704UVCpWcIKyvUl",
+	"zmEKKpGsMCREZ9H1EgiHFQlei4mQRC+BwAemNOMLIjiQuZCkHvckims+7QtZUqzaBLw4mlEFKSmEwmkh",
+	"Yo6d2/1IGHe/FaWOCReaJKLkSBUlF+/+SZZAU5CTTmBRmurSziQvc7OaHWvYuYRbsMaMIK5wyTW/Ocd+",
+	"dfZsCLG6n93Qm/Xt92ZIHuFz+uEn4Au9jM5evnoVD2xY5IbjCr1GUHn56lUbVGyXXRNxQXkC2bVkxWFo",
+	"KoEqwdvb6d2SSkjJiuklbpxgaZTfTAn2nVHcbnBkaDT7J5iNFycnJ3ebDtOCnY/20AWfM5lf1ZQdNgWJ",
+	"yL3c0ZyDt4VtgeSgFF0A0QLHrSUriFhxkA813IfcWnHEhTsjmsN9zUBTuTaoRZMElGIzljG9Jvj+ww21",
+	"WAoObXKuzJ8JL/MZSLPjvp+8+MspsWAZE5gsJuT/vHr14sVf/X+T6HCC4MVfTrdtM4S6cycYHbbFRJKU",
+	"UvUXIftiEc6fZjqDw/GsjTY1tb7x9z3m5SCBxsubl31E4g0yg2930FfpFYdSWDVwEI3h19up/InxmwPB",
+	"i2pYCLneJ6+ZHi78u5/iJq99fCDOVkLqqZApyDZ7G4IUoRJIxpSGlMzWhKoEeGpkkvrL2EAuN09R5mCC",
+	"T8hrmFMjTBpAPmmIUIzrb192yjAVl9wNURsKgAfUUmbNrSFZFN9XR6XMtgsGdlSWgn3b66DtnzF+c8jG",
+	"d99tp+lwkSUFpRmn1dZl3E/z6eGryfjfTnEQKGqpqRZTKzk3NKJ9KvWh2mTKbiGQK4Gn/rBoMs3PpdJk",
+	"BoTONUiiNJXavNhggXs6VVC+mVay8p6RHyA82w66jSSDKK2moT1fF5T/CSfMSawFVfc9WTEX2jTrjuIc",
+	"/tMpzVyevzkn5jExz2sxckmLArgijMekNPqbFkQCT0ESphVJUUlsAN6v1xdmCPCB5oVBtOhcMXp8LW7W",
+	"4g4CUEV5C2JCdgsnu96mHUzTWN7mZtoHCQfBlJnLQ2DKfddF02uv2AYKxvfeXDBIvUhh3+mM7V6YF419",
+	"ymobnfaKHlaI4BVrfUDFjKyoIjSTQNO1swmkhHbaH1qdSgvTU9bR42UKXLM5A4WduVc9vymQtyBJJhZq",
+	"snctcKLq4W8OtmuN+q1Hk+LvaOrJjO68Vilos/Pb8/LbkmrCFFlJwRdWjQaaLImYz52IM2eQpTFZLcHO",
+	"lZ+6OWUZpMRxqZF3orifTQ4pe40Utc1xu/fV0yzx1iW9cOuwcfrRZMk4HJlNbAxkxBovjKWLcoLGoglx",
+	"NqipI/OM5DQzO9xImCJF7bagkuagQSry59OTk2/qj7S4AX5GcqYU44uYwIfCkG4+cuB8A9x/VK/Q1K7Z",
+	"GVlBlh353krt2/VmNwVA3I4hfz59+fKbidGtp3NR8vSMnJ6cTvBQmCaZUJDGNVmScmvViz0PTylXKzDn",
+	"T21NnDaMj4ng84wl+qyxaklG1RJUbdhJSimBa6I01WCG9tdvJqTktNRLIdl/IA2mww9HyGpmFCiFdAlJ",
+	"KFFswY+8qRG3jV6TQopbZg40CXOcxT+fnrz4ZmJU+RlLUzPhSAjNMpB/UkSKDIjg9RmZClBosqRZJlaE",
+	"adPCt7hsGiSn2Rl5dYJ6gDdPbmyC2jRpVziKo9biRXFUrUUUR8E6hF9XCxHF0eZKhMbQcCms8wTXIoqj",
+	"cGot+NpJwF7scDrsp3EUMvewAwiBps1NP757+4YUVC+9pbgFTWhjcYe8WV/n2vj95fsJeGNfLYbU0kCL",
+	"9gB56vfzblm263tZZh1w8Ibm4Gk3b+APSDqZSXEDTfKwmyl2sxem7Iy5fnfD1Q+gaxODurONob8Dpu51",
+	"r+slbH7LGFAbv4OK2J/uzc7O/fG0cwi2jz7E2/YGzn9ae02be+x7KzyJOVktBcH3cJsZetzZzfCcv+Fi",
+	"xRvSlFeU9nugDzThHOKY3jD77KWtp6u6kHDLYNVnBFfu1fqrae1e6vnxO/tBy8I0xAzUGkNZpIOns9vw",
+	"08PPHtptmqvSGFNcb83GejeobU3kFjYx6pUz1zJQdzPYMhjE8d1dvy01yH78H3Q7aHSXnPsuho2z58Yf",
+	"atg3n5R6KubTFeNplx/3tyXoJVjHsbdwkznNMkVmMBcysB3YMxMlLzxDmcbTOhDDZ0JkQPmujb9rd4aO",
+	"gCbZgxYhWOen22zBTujQjaw9pOUENRPsRYyUrr3OY2b/T6o25/Q0KbUsK9Zr3m9vW9FP3cE+0nPqNjoy",
+	"f3o7+1en5WQAvb6Zod6N2sM8rV3VPY5U/G43mu9tZYChuWm07QcEPSGGqWliPd2NwJ+AtaW63bu4v6jb",
+	"4kKUXKto02Taj9p+h7RZ5uB0xp+mS6a0k2968XDdyHWtb3UwbWht7WUO7QS7PvbNqqeN5QhiVzbGurEH",
+	"486t7JZuBxcFtsdDWT+M3BgKo13db8XQodtwYzEadB42M4ecMX09HBXDHsCg3smxF3GqyIy9b1ZBE3vf",
+	"lDg56R2x0Bhl9i1uGIljXnd7YhoE2Ozvx3zQD2vMXvJY08XYzvfgl9POmJ/jFiOHHW9M2sYw3GR07U0M",
+	"SUMWv6o1oiECD5qShgy8dUrd/Vza5ZhruiCmPY+wAxf1oHNq+KHgjH3sAPfRxlzULQ0+VhrusuZedJti",
+	"636DO0fAPaCXd4xje6I4tn7hox0b6K5CxuXd+GiLp69h/AoCkWdC3NgpSkVSOnzOaYHcldyA7jSmh4ao",
+	"Yd7DtwXwHyQtliQHTVOqqdcPjUkQUlLQBcSkkKCAayJ4AqRpniFMEXQcTKKOyJZh1rmcLmDabYTa+7Fi",
+	"us5R2ft2ZT7Y8+aW+KSmhaumOyRj27I3jX4t/iqs0+LMBXgsAF3dXGgyB50sISVr0BM75Wd+LchSZKki",
+	"K+Olrb5TdK0InQnjuNMKsvmEeMde9U4iyizF1mfgOwg9T44aFCFoavQA28SWbahQlj1UtOfwQU+NiUZ0",
+	"BNhdUaUIVcQ+J1qQBdjBms/cPjWr6X1tGVWaOGvG/v1gyB6m0ZV5TuV+U59tOW4MrnNriAU7UMyqzrtO",
+	"Y79nZvRmKsK6zfptOQs9okOElU6UbjS0ddzP7Jzvfd68NWKOWrICNfs5yMMWcK/Mu3OBHWBzkZs2ELMD",
+	"v7l5hCFaAjeAObKNs7znJnh6YfsrlZm377lNtbQ7Voc2AqdyuiapCKMQJiQRUyEXlDMF8ozALci1Xhp3",
+	"Oe4YQwtJKMe4jxQysBlf0mfJ+BdtWzkYcfDMeBQJ5SmBlGlSG4JjBCCFj0wSppoQwyXmC3OsEMGzdXjq",
+	"hKShw9q0HsWR/ajz7LmiOlk+blZDIwq7Nqa+uIP8zfjfXmzJ2whMTUO9DgkU27MsIckY3/Y0p+sZdD/y",
+	"kkHHw00OqWSIipSgX9/J+y1j/mKSoLYt6rtWNuLQCav34zsbvnSg/DX8yI8jG4DUmubvgEqQLsDMHD3A",
+	"U+9uOncBQzbRrpWoWbddqv3Go19Vx47zUVHBgFxrXbvMn93VYX7YlmufbZsCrKwDWQVZGjCsd94tztb+",
+	"+NX+eRbm6097Tqvu6WCFy0W8g+nvsc14j+QCelTR4n4kiR1RFMHYAvhJJZ2bBh0MebOy7z20MjM+LaRY",
+	"SFCYCS/MfFi4qtxFURxRmSzZ7RalsdM7NjTebEApCynyjtg7ozfOXX57HRVJtBGlXKBKLe70UijFkI21",
+	"GbBniMQ24miL2hSqoE/Mmf0dv7gvd8eIGB0F8KgweG1OZFWjpKTuPSOXghFBfei/FoTp7kiRzxU47sG5",
+	"W9ncG4BQLUTXvvoVA7HG9ODueRmS2Nrc479AkdEElFW0UEHCmH8b4GtC/l0QppEdzY4WXlDFN2xqaZIB",
+	"ldZAN6bNjmmzvdNm7ebdMBwcxtsHOc5b5VJ2MtmzTaV9BmmsX0hy6IT8BHNNSp4sKV+YwiyGux30PbN0",
+	"0M6N6jTT4bWuBgkag4N5hukX+IpvcWeZM/Ml43PRYYNWBSRszhL6x3//+P+gSErJ+dUlJqURQWY0uTky",
+	"un9KCcVkoj/++8f/FeTdyiY/JYIrLcs//l9KSVpKyjUQQd789Bv5UZSSw9p8+Iswnk8FlqUchEeuCWMW",
+	"BKlc1ajJyeQEhb4COC1YdBZ9i3+Ko4LqJS7EsUlYOs6M28H8WgjVwUzn2Qq9Z87+grkRKHsKid6yIBN1",
+	"BpngCzyFqBVa16BjojC/QoJSoNCQ6pxshRQzSCfketMtY52raD21ZguHJy9ekZzx0vn6zZbC3Wr80tGV",
+	"UNqYU9CJElV5j9+JdG2tYFz7EoQFzr758vhfLu7U4vVemSF00Gzwj1F+qpgsZTf6y5OXg/r2aqdRqczW",
+	"a6pW2OFGQIQ990ll5foUR6cnJ/c2YJuF29FxmGpr+nz58uH7/JUXUiSgFEqLNgXRdP7qMQZ86TL4fFYs",
+	"uBfjSHnVM3pnuLtOlcQtPVsH9bMsMP8eGbaL3jvMzlmaZrCiEvBZUc4ylkTvTdMBfx5/xP9dpp/MEBbQ",
+	"wahvhHVa+LOlsqqh5VFpw8V4JCBHIsOqhHIO0r6L9BYSrO9bKjIXBlW8ewMfc2DI+6UCwjQpC8KFRPcz",
+	"9Qmjlp/9JDBFKluITU29evvu2rtfFM0BMxXb/PwD1OyM/1y+tonjLsE3Ovv9Y8TMuM33PijwLHLTFG3y",
+	"ZhzsgL1nQyv7HqfQ2CKIDgZXrywS8u8S5LqmxJtet9Ox2e/7Fn6c3C92VSlazxdKng83L8VKNZbb6qX1",
+	"NnCnHjOcIgfzd7zlvP3VnJJlUbPuhNgiGpYYawtC9mGSzJlUuvJmey6u7USWsytTt+lCkRKLkNgG3Bis",
+	"6yd3vGsZ2QEHBjVpI4FYhybNlCDKcLwilCQmSgv2HMcj/959L2/6s0YW7sHCQZRHfYZh+N79nMui1NsF",
+	"Z7di1qajNNWQgVIxSTJmhoiBav5wnYVOQsXyIlsbUDEHK9M7ucuQ0Np2p48tdran3cTauXAcjyc1Vhw2",
+	"4TkEsk+ntPAzRA/Igs7B2pPvXjyGRBxUnHhOjPcD6ApoaxdG35W37ul63QVLk2NTRMSo0FvF3+slbByH",
+	"yPaUG9VVlLzajlXVEntUMu0DH/Bo9VzJtHIIYTReliyb32KNiyW9BXILks2ZUYwHHr9U+9M3YzcQSBRg",
+	"m8HqKPd3IjsWecvS5MLPZetA3lD9G+EJiUiBMKVKa5sOp2PbEeqqAm0/MeOOxFwNjUPaxPyiMcopENv6",
+	"QoQd1tlvy+Ywqjo2Qf8xYVxpoM4zYUa0jQLLCaOAsN9c8BTgeHpy+vCdvjHB6Vho6DnB8d8ZZ2qJO9p6",
+	"imuJqFXL6fDjGWG6MiZ2YvQvkDIJiXVUme4xgmJRSkiJyb+4fE0uBOeQ6Ioej74KeBpoQAa+qkYcnE3I",
+	"OSZUKVNwy9rRuaiZm6mgu534WNsQA1799uTl9gF5UnxnURzZaC789CeRVJ6YXQgLPC0Ea59Uk52g8unr",
+	"3twtIf9+tnSYtHz8sZHG9OnYGsOPhA+T22uTs86dYTa5DvNbKqzJnd5AM2DOigkBlc5er3bY3KyVvRLR",
+	"OjkizMUOfr58fY7NV3GCvVT7zUsg7lHBrwixIUvzWpcCXjNnMD0N/e/5a/9bsyue9Sn/2Jh0evLXh+/x",
+	"wlf8e57GyiXl6RHG0FJORJstOk2YDixCoAzx72Bb5nVV/ZH4ooC1SkjVrmSdikFdtq/R4CbGWVqoFjNX",
+	"0I/j9aenAccYq7tZnBVY0c3pSEdVVsWEfCeMnigNKVgNNW2iQ9v0MqLiXVDx9CvxiT6NkvPtw3f696qy",
+	"6Yjyj4ry10bus9huRb8KxzuAvrIt8ZuhuB4Y4HaKwS7+fa/0iwarRMjUGazuLgHbyhlVnHpAl5WE3XOm",
+	"yILdglMOrs6vL/7Rz/W8FeFdOshTI3uYlfJlSbrtQjujiPss1e5K4mTViqFkhRtzi5hpzTN3ETOpTpa9",
+	"fObeFEQlVGZdVHgrvZkpUqXmoJDpcnM6ZD7T6wgJeyHh/iPutt8E+MnF332douUXL3ONEYXrmqfVRg0D",
+	"RFqff3cQku4V7VyO9dck2r12Qx5xfBTtRtGuLdo5SPiaRLsREh5OtAsrmozC3CjMffHCnAMT5Y13Nbg+",
+	"nBhXVS36WoS4n3HAI16PItwowrVFOISDRxbgrreCySYvJNS8wzLvjzaSmxc6ESCYfkzhb4SSUfQbRb9R",
+	"9LsHOPagZfEX0+PvUwSsSjZ3SnmmFrUvtoO91mmEpqSUQDGsrh4Vu+B+JuvEiWa24ITUYkAQkuvLMtoa",
+	"NjDXJgeoU3q7doWgdyYflEUiclv+27xuEyYw48IImMBTX/+7oEq3X8IXmpVxZmJ79oCJFo7iDlDxZCC5",
+	"qqvofEfcDc/WjiCsQMIwg0GXalfuQqk6+3/MEnQ7R7JaCgUkKBJifH2aMtwCTBENH3RM2IILiRGvVG1N",
+	"lNioNFKNeXeBoA7yqiIlWDMpzLvBJ/bGW6m0/TcmR/UH5lWBuewruiZUGoRsbpdG9ZrOVRNSd65ZWDul",
+	"7rLXhP9MP7C8zP2tEmLuxuMTfpokvjzZRlvGcqY3J9e0jPWs4ihn3P0WdxSt3aQqqNhex6bDLROlcuXm",
+	"qxA81NvmLNO+rICZpQk599Xq65AVnHzzlGBdK6uFmiIrkG4blW3jyVJs2lX9x/izZ5qBaJYqgAO3abfn",
+	"I+Jre+Kh6ijXdkioP9IexAmO6f9hLbBetWdePAgBn8HGH0VOI4TjmhFKOKyQCfpu9rZkefzR/M+VnsEC",
+	"+NBmgtf4d2QD80/Pag+24TupzGOA8RhgPAYY35eLwjCx105RmcIrl4zySCpVo/e56Wrrfoq3Fm14Fnhx",
+	"f5O/5UbnEUS+PhB5VrU4HEOndl/25l9/yYyRfMsuwbd8Mga+fym7XXG3l5Q9ChsjTowW+c9OPbLc3hFG",
+	"u1ugqa7i6lKPjutrvnZUKWKKSFFq49LMMmfXIzSztxOmWOdvBnoFUN9QVpsibVFcW47Zvhwby755VSjr",
+	"JRVleN/YhJxXP5Mio5wbp2ipFUvBWkWMjMd4KlZh7fmqwKBYcZLStbuwLKOLha/jKUo9FfOp/Xa7md+e",
+	"DTURX5CYVw9qlPRGSe9ZSXoBAATYVv+1j8S329b5pHz9UDbWzctensTOWhMxgsrXKxaOUlpoxA5BbT0M",
+	"0tyVqXsEtuOPvvWhdu4aAj3jPp4qHHc2XI9kNKyPoDZKSvvs3HfEla2Rr2/d/WXBtWVZ464fFwvvurcl",
+	"kmwALN7E1lMP3BLX+tVA1P0Lg503aI/WwBEhR7HvMY1zSuTgwROLL98TWpdbKtU1gfiOEFzqEYDv6IsZ",
+	"EXhE4BGBnwSB3R29D6h52yj08PaU3YZG9/4YVDbiyujn/ayCyhzrYnE4fxOgkEGO5r26YLWmyTI31O+6",
+	"LChEluCLxxPJOtJsMh+yPmcZuJB1phrY25UT4Z9Psatn4yANpnV0ZIw2v+dzJ5XlLosTmPDYEQQSokh/",
+	"T+nG1R7uAoar13+30RM/Xn3/Q0yu3vwQk99gdmUw8B/fX14QltOF5XeqSS6UJi9OyM/sO2sdNGxLmCIp",
+	"aEyNqhOo3JT63BYzrhhzI/ENphUxCNFdwfxp8W+n1piXmWYFlfrYNHOUUk2bO6R5mXgIfx1rYB+GJeGr",
+	"yQquq44JmxPK141L4ruJjyPzbWOUM8apXLdf3bhjHL/rvlD80V3M1ZqP2Dzqul97cj4yA6EWFA49D3Yq",
+	"u/Xnxx/rXwZ7mutm6h+f3JAYDGdUuUd4G0XPHe5mRJg7i56BL2OYfvtVoMauNReJBn2ktASaN9d+vzDX",
+	"WvnrSuiuih+4bq3QXknseBMhs6l0ZZEJaiqTjGgzos0DoY1YcbPJ7g1vKlW3Q7SxVrzeZvwL+/pnHiuM",
+	"g9jIFBs9kSOgjJlhX0LMMXJ3fZWNc1CgCQ8vCFyjbS0s0jY4Ib4LSHvepXW/N8m6XmvjmKnYa4aHlDoL",
+	"pC+qWc3H0Gq94QEw4LKch/C07KmniWPFsX9ulXnN9IaDG+vzjk7YzoLAFsxIWEewuyKwe0NNBlcO6n+Z",
+	"Q0xycQsBYVoEOIMRdkjMZi1jtT/oeUSasaTuiCmPcDtVFSTGU7PDPctucutdi48dY3ugeuual+79z1vZ",
+	"tKPovgDvMR2HHXSMnsNRN+3Zo+Dwdo7Mt6vv16VtI9xmjp64F9Xvt5hpHSIZAyzNJNB0HRS8duWL8bZ4",
+	"d1+J+QWvI+CCmBgBc0iDU71Gz2l1AFhMwDwNwSGUbIaWM98T1eeg/3hWZjch/ndH3FDy47u3b8hMpOvY",
+	"HE7wQR8n6hb/YFaYko4YE2eZr235F+/+aat3u2gRTESZ4J+lWNl0PjDbKjZnCJ5+OV3b/BDbCiVLoClI",
+	"8/7eMBx3Wn1nRvh5n1hmCHY09VEVDw3rue8gmzjy26DZa4eXZ9+ZevIgMzUepuNhOhp6n+D0yilfkwJE",
+	"kTVOMEI13iv0QCfZxkVtg+KA3FHRuKzoad35D3gB0jtwhu4CpHJXzJdSg6qMJo0bEeY0237vhDXfd10f",
+	"MBMiA8rHMKQR3kcD0T1mEd6KGyCUuMtr7nDf0R5YNQbsvgk/P+G7X0YZQhzLKDyOYUfPqvogcmPI4fiH",
+	"gzNprv11Q7Cqbh+iC8yKmYPG0CanqM9ocrPAq5ysNRqdXmXhHyMZjVwQvDkSDUJ7FfTHh42HqnJoRvKk",
+	"FQ4tASNojYknY3VDCEGzN2buyjbBD48/mv8N1SwR48w/T61QWuLHfJIRtUZRa1/5wsOAo9eVHl8cHjxU",
+	"qarBItWIRSMWjRLUPZepujcRCuPy1JIVR1pSruYgm2JUWz81XTmDtA8qaN7Or7QwlygLecP4oq1stkSx",
+	"t56Ea0/BWPpqxKpRbroLXPzG9DKVdOX8SdYmvaQ8PRK39rLtofcBrfh+y1UAAlhYFK+npxirAqm7Zicw",
+	"jJuci7WNLvFwQi28aOE+q+xXMVktWbIkOb2xQdR5nbxRZYgkpZRYJ8b+lSTiqLKmm4u5PcTZu4CYciWo",
+	"K5u9ItLCbLrXRva0oHX/wp0fRjWuQTLeyxE3R9wc40I+N6HyHwY4nUSJ54IWRHC8Le0hEv/C9np6MK/C",
+	"T76c+9TCYY3ugVFgfFY+zW1s3yd4YVcBhfD73VFhHSVDjXhnxToTrC7BJNNtSnj7Vc2Q78agsjGobITI",
+	"ESIHmOAc6IR6LqYS04fIBNkFl8dS2KSBzhs+OgDTqM0blIfQidlA5hXzlWkb40v23fWxFU1/MdR9IYj6",
+	"oM6UMMlQZDD6VUZcHf0qjxuZgpmONe6hZTSESZSZ7gzvnz79zwCPyokVOCQBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
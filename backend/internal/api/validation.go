@@ -0,0 +1,146 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// newValidator returns the validator shared by every handler, with the rules
+// of this domain registered on top of the stock ones.
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Report fields by the name clients know them by.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	_ = v.RegisterValidation("notpast", notPast)
+	v.RegisterStructValidation(tripRange, spec.CreateTripRequest{}, spec.UpdateTripRequest{})
+
+	return v
+}
+
+// notPast accepts times from now on. A minute of slack covers clients whose
+// clock runs a bit behind, or a form submitted with "now" as its start.
+func notPast(fl validator.FieldLevel) bool {
+	t, ok := fl.Field().Interface().(time.Time)
+	if !ok {
+		return false
+	}
+	return !t.Before(time.Now().Add(-time.Minute))
+}
+
+// tripRange checks that a trip ends after it starts.
+func tripRange(sl validator.StructLevel) {
+	var startsAt, endsAt time.Time
+	switch trip := sl.Current().Interface().(type) {
+	case spec.CreateTripRequest:
+		startsAt, endsAt = trip.StartsAt, trip.EndsAt
+	case spec.UpdateTripRequest:
+		startsAt, endsAt = trip.StartsAt, trip.EndsAt
+	default:
+		return
+	}
+
+	// Missing dates are already reported by required.
+	if startsAt.IsZero() || endsAt.IsZero() {
+		return
+	}
+
+	if !endsAt.After(startsAt) {
+		sl.ReportError(endsAt, "ends_at", "EndsAt", "after_start", "starts_at")
+	}
+}
+
+// validationError turns what the validator found into an error listing every
+// offending field.
+func validationError(r *http.Request, err error) spec.Error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return newError(r, spec.ErrorCodeValidationFailed, "invalid input: "+err.Error())
+	}
+
+	details := make([]spec.ErrorDetail, len(errs))
+	for i, fe := range errs {
+		details[i] = spec.ErrorDetail{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: ruleMessage(fe),
+		}
+	}
+
+	e := newError(r, spec.ErrorCodeValidationFailed, "invalid input")
+	e.Details = details
+	return e
+}
+
+// detailsSummary flattens an error into a single line, for places that can
+// only carry a string.
+func detailsSummary(e spec.Error) string {
+	if len(e.Details) == 0 {
+		return e.Message
+	}
+
+	parts := make([]string, len(e.Details))
+	for i, detail := range e.Details {
+		parts[i] = detail.Field + " " + detail.Message
+	}
+	return strings.Join(parts, "; ")
+}
+
+// fieldPath drops the struct name the validator puts in front of every
+// namespace, leaving e.g. invites[2].email.
+func fieldPath(fe validator.FieldError) string {
+	_, path, ok := strings.Cut(fe.Namespace(), ".")
+	if !ok {
+		return fe.Field()
+	}
+	return path
+}
+
+func ruleMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid e-mail address"
+	case "min":
+		return fmt.Sprintf("must be at least %s characters long", fe.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s characters long", fe.Param())
+	case "url":
+		return "must be a valid URL"
+	case "e164":
+		return "must be a phone number in E.164 format, like +5511912345678"
+	case "timezone":
+		return "must be an IANA time zone, like Europe/Lisbon"
+	case "notpast":
+		return "can't be in the past"
+	case "after_start":
+		return "must be after " + fe.Param()
+	default:
+		return fmt.Sprintf("failed the %q rule", fe.Tag())
+	}
+}
+
+// activityOutsideTrip is the detail reported for an activity that doesn't
+// fit in its trip.
+func activityOutsideTrip(field string, startsAt time.Time, endsAt time.Time) spec.ErrorDetail {
+	return spec.ErrorDetail{
+		Field:   field,
+		Rule:    "within_trip",
+		Message: fmt.Sprintf("must be between %s and %s", startsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339)),
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"SwallowGo/internal/api/spec"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	kiribati = time.FixedZone("UTC+14", 14*60*60)
+	hawaii   = time.FixedZone("UTC-10", -10*60*60)
+)
+
+func TestNotPast(t *testing.T) {
+	v := newValidator()
+	now := time.Now()
+	// The wall clock of now, read in a zone other than the one it was taken in.
+	wallClock := func(loc *time.Location) time.Time {
+		utc := now.UTC()
+		return time.Date(utc.Year(), utc.Month(), utc.Day(), utc.Hour(), utc.Minute(), utc.Second(), utc.Nanosecond(), loc)
+	}
+
+	tests := []struct {
+		name  string
+		value time.Time
+		valid bool
+	}{
+		{name: "now", value: now, valid: true},
+		{name: "later", value: now.Add(time.Hour), valid: true},
+		{name: "within the slack", value: now.Add(-59 * time.Second), valid: true},
+		{name: "past the slack", value: now.Add(-61 * time.Second)},
+		{name: "yesterday", value: now.AddDate(0, 0, -1)},
+		{name: "now elsewhere", value: now.In(kiribati), valid: true},
+		{name: "past elsewhere", value: now.Add(-2 * time.Minute).In(hawaii)},
+		{name: "utc wall clock ahead of utc", value: wallClock(hawaii), valid: true},
+		{name: "utc wall clock behind utc", value: wallClock(kiribati)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "notpast")
+			if tt.valid && err != nil {
+				t.Fatalf("err = %v, want none", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("err = nil, want notpast")
+			}
+		})
+	}
+
+	if err := v.Var("2030-01-01T00:00:00Z", "notpast"); err == nil {
+		t.Error("a string passed notpast")
+	}
+}
+
+func TestTripRange(t *testing.T) {
+	v := newValidator()
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name     string
+		startsAt time.Time
+		endsAt   time.Time
+		// wantTags are the rules reported, in order.
+		wantTags []string
+	}{
+		{name: "ends later", startsAt: startsAt, endsAt: startsAt.Add(72 * time.Hour)},
+		{name: "ends a nanosecond later", startsAt: startsAt, endsAt: startsAt.Add(time.Nanosecond)},
+		{name: "ends when it starts", startsAt: startsAt, endsAt: startsAt, wantTags: []string{"after_start"}},
+		{name: "ends before it starts", startsAt: startsAt, endsAt: startsAt.Add(-time.Hour), wantTags: []string{"after_start"}},
+		{name: "same instant in other zones", startsAt: startsAt.In(hawaii), endsAt: startsAt.In(kiribati), wantTags: []string{"after_start"}},
+		// Read as wall clocks the trip would end 23 hours before it starts.
+		{name: "ends later in an earlier zone", startsAt: startsAt.In(kiribati), endsAt: startsAt.Add(time.Hour).In(hawaii)},
+		{name: "ends earlier in a later zone", startsAt: startsAt.In(hawaii), endsAt: startsAt.Add(-time.Hour).In(kiribati), wantTags: []string{"after_start"}},
+		{name: "no end", startsAt: startsAt, wantTags: []string{"required"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			requests := map[string]any{
+				"create": spec.CreateTripRequest{
+					Destination:    "Lisbon",
+					EmailsToInvite: []types.Email{},
+					OwnerEmail:     "ada@example.com",
+					OwnerName:      "Ada",
+					StartsAt:       tt.startsAt,
+					EndsAt:         tt.endsAt,
+				},
+				"update": spec.UpdateTripRequest{Destination: "Lisbon", StartsAt: tt.startsAt, EndsAt: tt.endsAt},
+			}
+
+			for kind, request := range requests {
+				var tags []string
+				if err := v.Struct(request); err != nil {
+					var errs validator.ValidationErrors
+					if !errors.As(err, &errs) {
+						t.Fatalf("%s: err = %v", kind, err)
+					}
+					for _, fe := range errs {
+						if fe.Field() != "ends_at" {
+							t.Errorf("%s: %s reported on %s, want ends_at", kind, fe.Tag(), fe.Field())
+						}
+						tags = append(tags, fe.Tag())
+					}
+				}
+
+				if len(tags) != len(tt.wantTags) {
+					t.Fatalf("%s: rules = %v, want %v", kind, tags, tt.wantTags)
+				}
+				for i := range tags {
+					if tags[i] != tt.wantTags[i] {
+						t.Errorf("%s: rules = %v, want %v", kind, tags, tt.wantTags)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// Package auth issues the sessions of signed in users and carries the caller
+// of a request through its context.
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// User is the caller of a request, as vouched for by their session.
+type User struct {
+	ID    uuid.UUID
+	Email string
+}
+
+type contextKey struct{}
+
+// WithUser returns a copy of ctx carrying user as the caller.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, contextKey{}, user)
+}
+
+// UserFrom returns the caller ctx carries, if the request was signed in.
+func UserFrom(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(contextKey{}).(User)
+	return user, ok
+}
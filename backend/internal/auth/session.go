@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const DefaultSessionTTL = 7 * 24 * time.Hour
+
+var (
+	ErrMalformed = errors.New("auth: malformed session token")
+	ErrSignature = errors.New("auth: invalid session token signature")
+	ErrExpired   = errors.New("auth: session token expired")
+)
+
+const issuer = "swallowgo"
+
+var encoding = base64.RawURLEncoding
+
+// header is the only JWT header sessions are issued with, and the only one
+// accepted back. Tokens naming another algorithm, "none" included, are
+// rejected before their signature is looked at.
+var header = encoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+type claims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Email     string `json:"email"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Session is a signed in user's bearer token, a JWT signed with HMAC-SHA256.
+type Session struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type Sessions struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewSessions(secret []byte, ttl time.Duration) Sessions {
+	return Sessions{secret, ttl}
+}
+
+// Issue starts a session for user.
+func (s Sessions) Issue(user User) (Session, error) {
+	now := time.Now().Truncate(time.Second)
+	expiresAt := now.Add(s.ttl)
+
+	payload, err := json.Marshal(claims{
+		Issuer:    issuer,
+		Subject:   user.ID.String(),
+		Email:     user.Email,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return Session{}, fmt.Errorf("auth: failed to encode claims: %w", err)
+	}
+
+	signed := header + "." + encoding.EncodeToString(payload)
+	return Session{Token: signed + "." + encoding.EncodeToString(s.sign(signed)), ExpiresAt: expiresAt}, nil
+}
+
+// Verify checks that token was issued by these sessions and has not expired,
+// and returns the user it was issued for.
+func (s Sessions) Verify(token string) (User, error) {
+	signed, rawSig, ok := cutLast(token, ".")
+	if !ok {
+		return User{}, ErrMalformed
+	}
+
+	rawHeader, rawPayload, ok := strings.Cut(signed, ".")
+	if !ok || rawHeader != header {
+		return User{}, ErrMalformed
+	}
+
+	sig, err := encoding.DecodeString(rawSig)
+	if err != nil {
+		return User{}, ErrMalformed
+	}
+
+	if !hmac.Equal(sig, s.sign(signed)) {
+		return User{}, ErrSignature
+	}
+
+	payload, err := encoding.DecodeString(rawPayload)
+	if err != nil {
+		return User{}, ErrMalformed
+	}
+
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil || c.Issuer != issuer {
+		return User{}, ErrMalformed
+	}
+
+	id, err := uuid.Parse(c.Subject)
+	if err != nil {
+		return User{}, ErrMalformed
+	}
+
+	if !time.Now().Before(time.Unix(c.ExpiresAt, 0)) {
+		return User{}, ErrExpired
+	}
+
+	return User{ID: id, Email: c.Email}, nil
+}
+
+func (s Sessions) sign(signed string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+func cutLast(s string, sep string) (before string, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
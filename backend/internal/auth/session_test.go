@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var testSecret = []byte("a-secret-of-at-least-32-bytes-long")
+
+// forge signs payload under rawHeader with the secret of s, so tokens the
+// sessions would never issue still get past the signature.
+func forge(s Sessions, rawHeader string, payload string) string {
+	return signWith(s, rawHeader+"."+encoding.EncodeToString([]byte(payload)))
+}
+
+func signWith(s Sessions, signed string) string {
+	return signed + "." + encoding.EncodeToString(s.sign(signed))
+}
+
+func claimsJSON(t *testing.T, c claims) string {
+	t.Helper()
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestSessions(t *testing.T) {
+	s := NewSessions(testSecret, DefaultSessionTTL)
+	user := User{ID: uuid.New(), Email: "ada@example.com"}
+
+	session, err := s.Issue(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if until := time.Until(session.ExpiresAt); until <= DefaultSessionTTL-time.Minute || until > DefaultSessionTTL {
+		t.Errorf("expires in %v, want about %v", until, DefaultSessionTTL)
+	}
+
+	now := time.Now()
+	valid := claims{Issuer: issuer, Subject: user.ID.String(), Email: user.Email, IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()}
+	with := func(edit func(c *claims)) string {
+		c := valid
+		edit(&c)
+		return forge(s, header, claimsJSON(t, c))
+	}
+	signed, rawSig, _ := cutLast(session.Token, ".")
+	_, rawPayload, _ := strings.Cut(signed, ".")
+
+	expired, err := NewSessions(testSecret, -time.Minute).Issue(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewSessions([]byte("another-secret-of-32-bytes-or-more"), DefaultSessionTTL).Issue(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{name: "issued", token: session.Token},
+		{name: "forged with the secret", token: forge(s, header, claimsJSON(t, valid))},
+		{name: "expired", token: expired.Token, err: ErrExpired},
+		{name: "expires now", token: with(func(c *claims) { c.ExpiresAt = now.Unix() }), err: ErrExpired},
+		{name: "other secret", token: other.Token, err: ErrSignature},
+		{name: "flipped signature", token: signed + "." + encoding.EncodeToString([]byte("not-the-signature-of-the-token!!")), err: ErrSignature},
+		{name: "other payload", token: header + "." + encoding.EncodeToString([]byte(claimsJSON(t, valid))) + "." + rawSig, err: ErrSignature},
+		{name: "alg none", token: forge(s, encoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)), claimsJSON(t, valid)), err: ErrMalformed},
+		{name: "alg none unsigned", token: encoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + rawPayload + ".", err: ErrMalformed},
+		{name: "alg HS512", token: forge(s, encoding.EncodeToString([]byte(`{"alg":"HS512","typ":"JWT"}`)), claimsJSON(t, valid)), err: ErrMalformed},
+		{name: "reordered header", token: forge(s, encoding.EncodeToString([]byte(`{"typ":"JWT","alg":"HS256"}`)), claimsJSON(t, valid)), err: ErrMalformed},
+		{name: "empty", token: "", err: ErrMalformed},
+		{name: "one segment", token: "abc", err: ErrMalformed},
+		{name: "two segments", token: header + "." + rawSig, err: ErrMalformed},
+		{name: "signature not b64", token: signed + ".***", err: ErrMalformed},
+		{name: "payload not b64", token: signWith(s, header+".***"), err: ErrMalformed},
+		{name: "payload not json", token: forge(s, header, "not json"), err: ErrMalformed},
+		{name: "other issuer", token: with(func(c *claims) { c.Issuer = "someone-else" }), err: ErrMalformed},
+		{name: "subject not a uuid", token: with(func(c *claims) { c.Subject = "ada" }), err: ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Verify(tt.token)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %v, want a valid session", err)
+			}
+			if got != user {
+				t.Errorf("user = %+v, want %+v", got, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Package lifecycle moves trips through the statuses that follow from the
+// calendar rather than from something a user did.
+package lifecycle
+
+import (
+	"SwallowGo/internal/pgstore"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type store interface {
+	StartDueTrips(ctx context.Context) ([]uuid.UUID, error)
+	CompleteDueTrips(ctx context.Context) ([]uuid.UUID, error)
+}
+
+type Config struct {
+	// Interval is how often due trips are looked for. A trip enters
+	// in_progress or completed at most this long after its start or end.
+	Interval time.Duration
+}
+
+var DefaultConfig = Config{
+	Interval: time.Minute,
+}
+
+type Scheduler struct {
+	store  store
+	logger *zap.Logger
+	cfg    Config
+}
+
+func NewScheduler(pool *pgxpool.Pool, logger *zap.Logger, cfg Config) Scheduler {
+	return Scheduler{pgstore.New(pool), logger.Named("lifecycle"), cfg}
+}
+
+// Run advances due trips right away and then on every tick until ctx is
+// cancelled.
+func (s Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s Scheduler) tick(ctx context.Context) {
+	// Completing first keeps a trip that both starts and ends between two
+	// ticks in_progress for one interval instead of skipping the status.
+	completed, err := s.store.CompleteDueTrips(ctx)
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("failed to complete due trips", zap.Error(err))
+	}
+
+	started, err := s.store.StartDueTrips(ctx)
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("failed to start due trips", zap.Error(err))
+	}
+
+	if len(started) > 0 || len(completed) > 0 {
+		s.logger.Info("advanced trips", zap.Int("started", len(started)), zap.Int("completed", len(completed)))
+	}
+}
@@ -0,0 +1,26 @@
+package mailer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// Log only logs the messages it is given. Bodies are left out, they carry
+// sign-in and confirmation links that must not end up in the logs.
+type Log struct {
+	logger *zap.Logger
+}
+
+func NewLog(logger *zap.Logger) Log {
+	return Log{logger.Named("mailer")}
+}
+
+func (l Log) Send(_ context.Context, msg Message) error {
+	l.logger.Info("email",
+		zap.String("from", msg.From),
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+	)
+	return nil
+}
@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Maildir writes every message as a file into a maildir, which most mail
+// clients can open directly. Meant for local development.
+type Maildir struct {
+	dir      string
+	hostname string
+	seq      atomic.Uint64
+}
+
+func NewMaildir(dir string) (*Maildir, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("mailer: failed to create maildir: %w", err)
+		}
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+
+	return &Maildir{dir: dir, hostname: hostname}, nil
+}
+
+func (md *Maildir) Send(_ context.Context, msg Message) error {
+	m, err := goMail(msg)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to build message: %w", err)
+	}
+
+	// Deliver into tmp and rename into new so readers never see partial files.
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "." +
+		strconv.Itoa(os.Getpid()) + "_" + strconv.FormatUint(md.seq.Add(1), 10) + "." + md.hostname
+	tmp := filepath.Join(md.dir, "tmp", name)
+
+	if err := m.WriteToFile(tmp); err != nil {
+		return fmt.Errorf("mailer: failed to write message: %w", err)
+	}
+
+	if err := os.Rename(tmp, filepath.Join(md.dir, "new", name)); err != nil {
+		return fmt.Errorf("mailer: failed to deliver message: %w", err)
+	}
+
+	return nil
+}
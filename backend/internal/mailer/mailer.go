@@ -0,0 +1,421 @@
+// Package mailer composes the e-mails SwallowGo sends and hands them to a
+// pluggable Transport for delivery.
+package mailer
+
+import (
+	"SwallowGo/internal/pgstore"
+	"SwallowGo/internal/tokens"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type store interface {
+	//Trips
+	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	TransitionTrip(ctx context.Context, tripID uuid.UUID, to pgstore.TripStatus) error
+	//Participants
+	GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
+	GetPendingOwnershipTransfer(ctx context.Context, tripID uuid.UUID) (pgstore.OwnershipTransfer, error)
+	//Tokens
+	CreateConfirmationToken(ctx context.Context, arg pgstore.CreateConfirmationTokenParams) error
+	CreateLoginToken(ctx context.Context, arg pgstore.CreateLoginTokenParams) error
+}
+
+type Mailer struct{
+	store store
+	signer tokens.Signer
+	baseURL *url.URL
+	from string
+	transport Transport
+}
+
+// New creates a mailer whose links point at baseURL, the public address the
+// API is reachable at, and that delivers through transport.
+func New(pool *pgxpool.Pool, signer tokens.Signer, baseURL *url.URL, from string, transport Transport) Mailer {
+	return Mailer{pgstore.New(pool), signer, baseURL, from, transport}
+}
+
+func (m Mailer) SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	proceed, err := m.awaitConfirmation(ctx, trip)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to update trip for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+	if !proceed {
+		return nil
+	}
+
+	token, err := m.issueToken(ctx, tokens.PurposeTripConfirm, trip.ID, pgtype.UUID{})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to issue token for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	content, err := renderMail(
+		"confirm_trip",
+		fmt.Sprintf(`SwallowGo - Action Needed: Confirm Your Trip To %s!`,trip.Destination,),
+		mailData{
+			Name:        trip.OwnerName,
+			Destination: trip.Destination,
+			StartsAt:    startDate(trip),
+			ActionURL:   m.actionURL(token, "trips", trip.ID.String(), "confirm"),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("mailer: failed create email body SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	if err := m.send(ctx, content, trip.OwnerEmail); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+	return nil
+}
+
+func (m Mailer) ReSendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for ReSendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	proceed, err := m.awaitConfirmation(ctx, trip)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to update trip for ReSendConfirmTripEmailToTripOwner: %w", err)
+	}
+	if !proceed {
+		return nil
+	}
+
+	token, err := m.issueToken(ctx, tokens.PurposeTripConfirm, trip.ID, pgtype.UUID{})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to issue token for ReSendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	content, err := renderMail(
+		"reconfirm_trip",
+		fmt.Sprintf(`SwallowGo - Action Required: Reconfirm Your Trip To %s!`,trip.Destination,),
+		mailData{
+			Name:        trip.OwnerName,
+			Destination: trip.Destination,
+			StartsAt:    startDate(trip),
+			ActionURL:   m.actionURL(token, "trips", trip.ID.String(), "confirm"),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("mailer: failed create email body ReSendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	if err := m.send(ctx, content, trip.OwnerEmail); err != nil {
+		return fmt.Errorf("mailer: failed to send email for ReSendConfirmTripEmailToTripOwner: %w", err)
+	}
+	return nil
+}
+
+func (m Mailer) SendConfirmTripEmailToTripinvitation(ctx context.Context, tripID uuid.UUID,ParticipantID uuid.UUID) error {
+	participant, err := m.store.GetParticipant(ctx, ParticipantID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendConfirmTripEmailToTripinvitations: %w", err)
+	}
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	token, err := m.issueToken(ctx, tokens.PurposeParticipantConfirm, trip.ID, pgtype.UUID{Bytes: participant.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to issue token for SendConfirmTripEmailToTripinvitation: %w", err)
+	}
+
+	name := participant.Email
+	if participant.Name.Valid {
+		name = participant.Name.String
+	}
+
+	content, err := renderMail(
+		"trip_invitation",
+		fmt.Sprintf(`SwallowGo - Your Trip to %s is Confirmed!`,trip.Destination,),
+		mailData{
+			Name:        name,
+			Destination: trip.Destination,
+			StartsAt:    startDate(trip),
+			ActionURL:   m.actionURL(token, "participants", participant.ID.String(), "confirm"),
+			DeclineURL:  m.actionURL(token, "participants", participant.ID.String(), "decline"),
+			MaybeURL:    m.actionURL(token, "participants", participant.ID.String(), "maybe"),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("mailer: failed create email body SendConfirmTripEmailToTripinvitation: %w", err)
+	}
+
+	if err := m.send(ctx, content, participant.Email); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendConfirmTripEmailToTripinvitation: %w", err)
+	}
+
+	return nil
+}
+
+// SendTripCancelledEmail tells a participant the trip they were invited to was
+// cancelled.
+func (m Mailer) SendTripCancelledEmail(ctx context.Context, tripID uuid.UUID, participantID uuid.UUID) error {
+	participant, err := m.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendTripCancelledEmail: %w", err)
+	}
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendTripCancelledEmail: %w", err)
+	}
+
+	name := participant.Email
+	if participant.Name.Valid {
+		name = participant.Name.String
+	}
+
+	content, err := renderMail(
+		"trip_cancelled",
+		fmt.Sprintf("SwallowGo - Your Trip to %s Was Cancelled", trip.Destination),
+		mailData{
+			Name:        name,
+			Destination: trip.Destination,
+			StartsAt:    startDate(trip),
+			Reason:      trip.CancellationReason.String,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("mailer: failed create email body SendTripCancelledEmail: %w", err)
+	}
+
+	if err := m.send(ctx, content, participant.Email); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendTripCancelledEmail: %w", err)
+	}
+
+	return nil
+}
+
+// SendParticipantRemovedEmail lets someone know they were taken off a trip.
+// The participant is already gone at this point, so their address is passed in.
+func (m Mailer) SendParticipantRemovedEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error {
+	if err := m.sendFarewell(ctx, "participant_removed", "SwallowGo - You Were Removed From the Trip to %s", tripID, email, name); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendParticipantRemovedEmail: %w", err)
+	}
+	return nil
+}
+
+// SendInvitationRevokedEmail lets someone know their invitation was withdrawn.
+func (m Mailer) SendInvitationRevokedEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error {
+	if err := m.sendFarewell(ctx, "invitation_revoked", "SwallowGo - Your Invitation to %s Was Withdrawn", tripID, email, name); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendInvitationRevokedEmail: %w", err)
+	}
+	return nil
+}
+
+// SendMagicLinkEmail sends a sign-in link to email. The link is only good
+// for tokens.LoginTTL and a single sign in.
+func (m Mailer) SendMagicLinkEmail(ctx context.Context, email string) error {
+	loginID := uuid.New()
+	token, err := m.signer.WithTTL(tokens.LoginTTL).Issue(tokens.PurposeLogin, loginID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to issue token for SendMagicLinkEmail: %w", err)
+	}
+
+	if err := m.store.CreateLoginToken(ctx, pgstore.CreateLoginTokenParams{
+		ID:        loginID,
+		TokenHash: token.Hash,
+		Email:     email,
+		ExpiresAt: pgtype.Timestamptz{Valid: true, Time: token.ExpiresAt},
+	}); err != nil {
+		return fmt.Errorf("mailer: failed to store token for SendMagicLinkEmail: %w", err)
+	}
+
+	content, err := renderMail(
+		"magic_link",
+		"SwallowGo - Your Sign-In Link",
+		mailData{
+			Name:      email,
+			ActionURL: m.actionURL(token.Value, "auth", "login", loginID.String()),
+			ExpiresIn: fmt.Sprintf("%d minutes", int(tokens.LoginTTL.Minutes())),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("mailer: failed create email body SendMagicLinkEmail: %w", err)
+	}
+
+	if err := m.send(ctx, content, email); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendMagicLinkEmail: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnershipTransferEmail asks a participant to accept taking the trip
+// over from its owner. It is skipped when the owner withdrew the transfer
+// before it was sent.
+func (m Mailer) SendOwnershipTransferEmail(ctx context.Context, tripID uuid.UUID, participantID uuid.UUID) error {
+	transfer, err := m.store.GetPendingOwnershipTransfer(ctx, tripID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && transfer.ParticipantID != participantID) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get transfer for SendOwnershipTransferEmail: %w", err)
+	}
+
+	participant, err := m.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendOwnershipTransferEmail: %w", err)
+	}
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendOwnershipTransferEmail: %w", err)
+	}
+
+	token, err := m.issueToken(ctx, tokens.PurposeOwnershipTransfer, trip.ID, pgtype.UUID{Bytes: participant.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to issue token for SendOwnershipTransferEmail: %w", err)
+	}
+
+	name := participant.Email
+	if participant.Name.Valid {
+		name = participant.Name.String
+	}
+
+	content, err := renderMail(
+		"ownership_transfer",
+		fmt.Sprintf("SwallowGo - Take Over the Trip to %s?", trip.Destination),
+		mailData{
+			Name:        name,
+			Destination: trip.Destination,
+			StartsAt:    startDate(trip),
+			ActionURL:   m.actionURL(token, "participants", participant.ID.String(), "accept-ownership"),
+			OwnerName:   trip.OwnerName,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("mailer: failed create email body SendOwnershipTransferEmail: %w", err)
+	}
+
+	if err := m.send(ctx, content, participant.Email); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendOwnershipTransferEmail: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnershipReceivedEmail tells the new owner of a trip that it is theirs.
+func (m Mailer) SendOwnershipReceivedEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error {
+	if err := m.sendFarewell(ctx, "ownership_received", "SwallowGo - You Now Own the Trip to %s", tripID, email, name); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendOwnershipReceivedEmail: %w", err)
+	}
+	return nil
+}
+
+// SendOwnershipHandedOverEmail tells the previous owner of a trip that the
+// participant they nominated took it over.
+func (m Mailer) SendOwnershipHandedOverEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error {
+	if err := m.sendFarewell(ctx, "ownership_handed_over", "SwallowGo - You Handed Over the Trip to %s", tripID, email, name); err != nil {
+		return fmt.Errorf("mailer: failed to send email for SendOwnershipHandedOverEmail: %w", err)
+	}
+	return nil
+}
+
+func (m Mailer) sendFarewell(ctx context.Context, template string, subject string, tripID uuid.UUID, email string, name string) error {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return err
+	}
+
+	if name == "" {
+		name = email
+	}
+
+	content, err := renderMail(
+		template,
+		fmt.Sprintf(subject, trip.Destination),
+		mailData{
+			Name:        name,
+			Destination: trip.Destination,
+			StartsAt:    startDate(trip),
+			OwnerName:   trip.OwnerName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return m.send(ctx, content, email)
+}
+
+// awaitConfirmation moves a draft trip to pending_confirmation before its owner
+// is asked to confirm it. It reports false when the trip no longer needs
+// confirming, in which case the e-mail is skipped.
+func (m Mailer) awaitConfirmation(ctx context.Context, trip pgstore.Trip) (bool, error) {
+	if trip.Status != pgstore.TripStatusDraft {
+		return trip.Status == pgstore.TripStatusPendingConfirmation, nil
+	}
+
+	err := m.store.TransitionTrip(ctx, trip.ID, pgstore.TripStatusPendingConfirmation)
+	var invalid *pgstore.InvalidTransitionError
+	if errors.As(err, &invalid) {
+		// The owner confirmed in the meantime.
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// startDate is the day the trip starts on, where it happens.
+func startDate(trip pgstore.Trip) string {
+	return trip.StartsAt.Time.In(trip.Location()).Format(time.DateOnly)
+}
+
+// actionURL builds an absolute link to an API route carrying the given token.
+func (m Mailer) actionURL(token string, elem ...string) string {
+	u := m.baseURL.JoinPath(elem...)
+	u.RawQuery = url.Values{"token": {token}}.Encode()
+	return u.String()
+}
+
+// issueToken signs a new confirmation token and stores its hash so the API can
+// later consume it. The token subject is the participant when one is given,
+// otherwise the trip.
+func (m Mailer) issueToken(ctx context.Context, purpose tokens.Purpose, tripID uuid.UUID, participantID pgtype.UUID) (string, error) {
+	subjectID := tripID
+	if participantID.Valid {
+		subjectID = participantID.Bytes
+	}
+
+	token, err := m.signer.Issue(purpose, subjectID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := m.store.CreateConfirmationToken(ctx, pgstore.CreateConfirmationTokenParams{
+		TokenHash:     token.Hash,
+		Purpose:       string(purpose),
+		TripID:        tripID,
+		ParticipantID: participantID,
+		ExpiresAt:     pgtype.Timestamptz{Valid: true, Time: token.ExpiresAt},
+	}); err != nil {
+		return "", err
+	}
+
+	return token.Value, nil
+}
+
+func (m Mailer) send(ctx context.Context, content mailContent, email string) error {
+	return m.transport.Send(ctx, Message{
+		From:    m.from,
+		To:      email,
+		Subject: content.Subject,
+		Text:    content.Text,
+		HTML:    content.HTML,
+	})
+}
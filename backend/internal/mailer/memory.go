@@ -0,0 +1,40 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+)
+
+// Recorder keeps every message in memory so tests can assert on them.
+type Recorder struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Send(_ context.Context, msg Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = append(r.messages, msg)
+	return nil
+}
+
+// Messages returns a copy of the messages sent so far.
+func (r *Recorder) Messages() []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Message(nil), r.messages...)
+}
+
+// Reset forgets all recorded messages.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = nil
+}
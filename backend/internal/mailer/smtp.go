@@ -0,0 +1,81 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wneessen/go-mail"
+)
+
+type TLSMode string
+
+const (
+	// TLSNone sends in plain text, as the local mailpit container expects.
+	TLSNone TLSMode = "none"
+	// TLSStartTLS upgrades the connection with STARTTLS and refuses to send
+	// if the server does not support it.
+	TLSStartTLS TLSMode = "starttls"
+	// TLSImplicit connects over TLS right away, usually on port 465.
+	TLSImplicit TLSMode = "tls"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLS      TLSMode
+}
+
+// SMTP delivers messages through an SMTP server or relay.
+type SMTP struct {
+	opts []mail.Option
+	host string
+}
+
+func NewSMTP(cfg SMTPConfig) (SMTP, error) {
+	if cfg.Host == "" {
+		return SMTP{}, fmt.Errorf("mailer: smtp host is required")
+	}
+
+	opts := []mail.Option{mail.WithPort(cfg.Port)}
+	switch cfg.TLS {
+	case TLSNone:
+		opts = append(opts, mail.WithTLSPolicy(mail.NoTLS))
+	case TLSStartTLS:
+		opts = append(opts, mail.WithTLSPolicy(mail.TLSMandatory))
+	case TLSImplicit:
+		opts = append(opts, mail.WithSSL())
+	default:
+		return SMTP{}, fmt.Errorf("mailer: unknown smtp tls mode %q", cfg.TLS)
+	}
+
+	if cfg.Username != "" {
+		opts = append(opts,
+			mail.WithSMTPAuth(mail.SMTPAuthPlain),
+			mail.WithUsername(cfg.Username),
+			mail.WithPassword(cfg.Password),
+		)
+	}
+
+	return SMTP{opts, cfg.Host}, nil
+}
+
+func (s SMTP) Send(ctx context.Context, msg Message) error {
+	m, err := goMail(msg)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to build message: %w", err)
+	}
+
+	// A client holds a single connection, so each send gets its own.
+	client, err := mail.NewClient(s.host, s.opts...)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to create smtp client: %w", err)
+	}
+
+	if err := client.DialAndSendWithContext(ctx, m); err != nil {
+		return fmt.Errorf("mailer: failed to send through smtp: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+var (
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templatesFS, "templates/*.html"))
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templatesFS, "templates/*.txt"))
+)
+
+type mailData struct {
+	Name        string
+	Destination string
+	StartsAt    string
+	ActionURL   string
+	// DeclineURL and MaybeURL are only set for invitations.
+	DeclineURL string
+	MaybeURL   string
+	// Reason is only set for cancellations.
+	Reason string
+	// ExpiresIn is only set for sign-in links.
+	ExpiresIn string
+	// OwnerName is the owner of the trip at the time the e-mail is sent.
+	OwnerName string
+}
+
+type mailContent struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// renderMail executes the html and plain-text variants of the named template.
+func renderMail(name string, subject string, data mailData) (mailContent, error) {
+	var html bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return mailContent{}, err
+	}
+
+	var text bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt", data); err != nil {
+		return mailContent{}, err
+	}
+
+	return mailContent{Subject: subject, HTML: html.String(), Text: text.String()}, nil
+}
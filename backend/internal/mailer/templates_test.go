@@ -0,0 +1,69 @@
+package mailer
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestRenderMail(t *testing.T) {
+	data := mailData{
+		Name:        "Ada <Lovelace>",
+		Destination: "Lisbon & Porto",
+		StartsAt:    "March 14, 2025",
+		ActionURL:   "https://swallow.example/trips/1/confirm?token=a&b=c",
+		DeclineURL:  "https://swallow.example/participants/2/decline?token=d",
+		MaybeURL:    "https://swallow.example/participants/2/maybe?token=m",
+		Reason:      "The flights were cancelled.",
+		ExpiresIn:   "15 minutes",
+		OwnerName:   "Grace Hopper",
+	}
+
+	entries, err := templatesFS.ReadDir("templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every template comes as a pair, renderMail fails if either half is missing.
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) != ".txt" {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".txt")
+		t.Run(name, func(t *testing.T) {
+			content, err := renderMail(name, "Subject", data)
+			if err != nil {
+				t.Fatalf("renderMail(%q): %v", name, err)
+			}
+
+			assertGolden(t, filepath.Join("testdata", name+".txt.golden"), content.Text)
+			assertGolden(t, filepath.Join("testdata", name+".html.golden"), content.HTML)
+		})
+	}
+}
+
+func assertGolden(t *testing.T, path string, got string) {
+	t.Helper()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs from the rendered output:\n--- want\n%s\n--- got\n%s", path, want, got)
+	}
+}
@@ -0,0 +1,108 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+)
+
+// Message is a fully rendered e-mail, ready to be handed to a Transport.
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Transport delivers rendered messages. Implementations must be safe for
+// concurrent use.
+type Transport interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Backend string
+
+const (
+	BackendSMTP Backend = "smtp"
+	BackendFile Backend = "file"
+	BackendLog  Backend = "log"
+)
+
+type Config struct {
+	Backend Backend
+	From    string
+	SMTP    SMTPConfig
+	// Dir is the maildir the file backend writes into.
+	Dir string
+}
+
+// ConfigFromEnv reads the mailer configuration from the environment. The
+// defaults match the mailpit container from compose.yml.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Backend: Backend(envOr("SWALLOWGO_MAILER_BACKEND", string(BackendSMTP))),
+		From:    envOr("SWALLOWGO_EMAIL_FROM", "contact@swallowgo.com"),
+		SMTP: SMTPConfig{
+			Host:     os.Getenv("SWALLOWGO_EMAIL_HOST"),
+			Username: os.Getenv("SWALLOWGO_EMAIL_USERNAME"),
+			Password: os.Getenv("SWALLOWGO_EMAIL_PASSWORD"),
+			TLS:      TLSMode(envOr("SWALLOWGO_EMAIL_TLS", string(TLSNone))),
+		},
+		Dir: envOr("SWALLOWGO_MAILDIR", "./maildir"),
+	}
+
+	port, err := strconv.Atoi(envOr("SWALLOWGO_EMAIL_PORT", "1025"))
+	if err != nil {
+		return Config{}, fmt.Errorf("mailer: invalid SWALLOWGO_EMAIL_PORT: %w", err)
+	}
+	cfg.SMTP.Port = port
+
+	return cfg, nil
+}
+
+// NewTransport builds the Transport selected by cfg.Backend. The in-memory
+// Recorder is left out on purpose, it would swallow every e-mail of a running
+// server; tests construct it with NewRecorder.
+func NewTransport(cfg Config, logger *zap.Logger) (Transport, error) {
+	switch cfg.Backend {
+	case BackendSMTP:
+		return NewSMTP(cfg.SMTP)
+	case BackendFile:
+		return NewMaildir(cfg.Dir)
+	case BackendLog:
+		return NewLog(logger), nil
+	default:
+		return nil, fmt.Errorf("mailer: unknown backend %q", cfg.Backend)
+	}
+}
+
+func envOr(key string, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// goMail converts msg into a multipart go-mail message.
+func goMail(msg Message) (*mail.Msg, error) {
+	m := mail.NewMsg()
+	if err := m.From(msg.From); err != nil {
+		return nil, err
+	}
+
+	if err := m.To(msg.To); err != nil {
+		return nil, err
+	}
+
+	m.Subject(msg.Subject)
+	m.SetDate()
+	m.SetMessageID()
+	m.SetBodyString(mail.TypeTextPlain, msg.Text)
+	m.AddAlternativeString(mail.TypeTextHTML, msg.HTML)
+	return m, nil
+}
@@ -0,0 +1,45 @@
+package mailer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestNewTransportRefusesMemory(t *testing.T) {
+	if _, err := NewTransport(Config{Backend: "memory"}, zap.NewNop()); err == nil {
+		t.Fatal("the memory backend must not be selectable in a running server")
+	}
+}
+
+func TestLogLeavesBodiesOut(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	transport := NewLog(zap.New(core))
+
+	err := transport.Send(context.Background(), Message{
+		From:    "contact@swallowgo.com",
+		To:      "ada@example.com",
+		Subject: "Sign in",
+		Text:    "https://swallow.example/auth/verify?token=secret",
+		HTML:    `<a href="https://swallow.example/auth/verify?token=secret">Sign in</a>`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(entries))
+	}
+	for key, value := range entries[0].ContextMap() {
+		if s, ok := value.(string); ok && strings.Contains(s, "token=") {
+			t.Errorf("field %q leaks the message body: %q", key, s)
+		}
+	}
+	if got := entries[0].ContextMap()["to"]; got != "ada@example.com" {
+		t.Errorf("to = %v, want ada@example.com", got)
+	}
+}
@@ -0,0 +1,272 @@
+// Package oidc signs users in with an OpenID Connect provider, through the
+// authorization code flow with PKCE. Any provider publishing its discovery
+// document and signing ID tokens with RS256 will do.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrExchange is returned when the provider refuses to trade the
+	// authorization code for tokens, typically because it expired or was
+	// already used.
+	ErrExchange = errors.New("oidc: provider refused the authorization code")
+	// ErrInvalidIDToken is returned when the ID token fails verification.
+	ErrInvalidIDToken = errors.New("oidc: invalid id token")
+)
+
+// maxResponseBytes caps what is read of any response of the provider.
+const maxResponseBytes = 1 << 20
+
+type Config struct {
+	// Issuer is the URL the provider identifies itself with, its discovery
+	// document is served under it.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is where the provider sends the user back to, it must be
+	// registered with the provider.
+	RedirectURL string
+	Scopes      []string
+}
+
+// Enabled reports whether a provider is configured at all.
+func (c Config) Enabled() bool {
+	return c.Issuer != ""
+}
+
+// ConfigFromEnv reads the provider configuration from the environment. The
+// sign-in is disabled unless SWALLOWGO_OIDC_ISSUER is set. The redirect URL
+// defaults to the callback under baseURL.
+func ConfigFromEnv(baseURL *url.URL) (Config, error) {
+	cfg := Config{
+		Issuer:       os.Getenv("SWALLOWGO_OIDC_ISSUER"),
+		ClientID:     os.Getenv("SWALLOWGO_OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("SWALLOWGO_OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("SWALLOWGO_OIDC_REDIRECT_URL"),
+		Scopes:       strings.Fields(os.Getenv("SWALLOWGO_OIDC_SCOPES")),
+	}
+
+	if !cfg.Enabled() {
+		return cfg, nil
+	}
+
+	if cfg.ClientID == "" {
+		return Config{}, errors.New("oidc: SWALLOWGO_OIDC_CLIENT_ID is not set")
+	}
+
+	if cfg.RedirectURL == "" {
+		cfg.RedirectURL = baseURL.JoinPath("auth", "oidc", "callback").String()
+	}
+
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return cfg, nil
+}
+
+// metadata is the part of the discovery document the flow relies on.
+type metadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+}
+
+// Provider is a configured OpenID Connect provider. Its discovery document
+// is fetched on first use rather than at startup, so an unreachable provider
+// only breaks its own sign-in.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *metadata
+	keys *keySet
+}
+
+// NewProvider returns the provider cfg describes. client is used for every
+// request to it, a client timing out after 10 seconds when nil.
+func NewProvider(cfg Config, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Provider{cfg: cfg, client: client}
+}
+
+// Issuer is the issuer the provider was configured with, which with the
+// subject of an ID token identifies an account.
+func (p *Provider) Issuer() string {
+	return p.cfg.Issuer
+}
+
+// discover returns the provider metadata, fetching it the first time.
+// Failures are not cached, the next sign-in tries again.
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	var meta metadata
+	if err := p.getJSON(ctx, wellKnown, &meta); err != nil {
+		return nil, fmt.Errorf("oidc: failed to discover provider: %w", err)
+	}
+
+	// The issuer is what ID tokens are checked against, a document naming
+	// another one was not published by this provider.
+	if meta.Issuer != p.cfg.Issuer {
+		return nil, fmt.Errorf("oidc: discovery document is for issuer %q, expected %q", meta.Issuer, p.cfg.Issuer)
+	}
+
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document lacks an endpoint")
+	}
+
+	if len(meta.CodeChallengeMethods) > 0 && !contains(meta.CodeChallengeMethods, challengeMethod) {
+		return nil, errors.New("oidc: provider does not support S256 code challenges")
+	}
+
+	p.meta = &meta
+	p.keys = newKeySet(p.client, meta.JWKSURI)
+	return p.meta, nil
+}
+
+// AuthCodeURL returns the URL of the provider's consent page for a new
+// sign-in. state and nonce come back with the user and in the ID token, the
+// challenge of verifier binds the code to whoever holds verifier.
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc: invalid authorization endpoint: %w", err)
+	}
+
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", Challenge(verifier))
+	query.Set("code_challenge_method", challengeMethod)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange trades an authorization code for the provider's tokens and returns
+// the claims of the verified ID token.
+func (p *Provider) Exchange(ctx context.Context, code string, verifier string, nonce string) (Claims, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {verifier},
+	}
+	if p.cfg.ClientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Claims{}, fmt.Errorf("oidc: failed to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return Claims{}, fmt.Errorf("oidc: failed to reach token endpoint: %w", err)
+	}
+	defer res.Body.Close()
+
+	var tokens struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes)).Decode(&tokens); err != nil {
+		return Claims{}, fmt.Errorf("oidc: failed to decode token response with status %d: %w", res.StatusCode, err)
+	}
+
+	if res.StatusCode == http.StatusBadRequest && tokens.Error != "" {
+		return Claims{}, fmt.Errorf("%w: %s %s", ErrExchange, tokens.Error, tokens.ErrorDescription)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return Claims{}, fmt.Errorf("oidc: token endpoint answered with status %d: %s", res.StatusCode, tokens.Error)
+	}
+
+	if tokens.IDToken == "" {
+		return Claims{}, fmt.Errorf("%w: token response has no id token", ErrInvalidIDToken)
+	}
+
+	return p.verify(ctx, meta, tokens.IDToken, nonce)
+}
+
+func (p *Provider) getJSON(ctx context.Context, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return doJSON(p.client, req, v)
+}
+
+func doJSON(client *http.Client, req *http.Request, v any) error {
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s answered with status %d", req.URL.Redacted(), res.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes)).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", req.URL.Redacted(), err)
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+package oidc
+
+import (
+	"SwallowGo/internal/oidc/oidctest"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const testClientID = "swallowgo"
+
+func newTestProvider(t *testing.T) (*Provider, *oidctest.Issuer) {
+	t.Helper()
+
+	iss := oidctest.NewIssuer(t, testClientID)
+	p := NewProvider(Config{
+		Issuer:      iss.URL,
+		ClientID:    testClientID,
+		RedirectURL: "http://localhost/auth/oidc/callback",
+		Scopes:      []string{"openid", "email"},
+	}, http.DefaultClient)
+	return p, iss
+}
+
+func randomString(t *testing.T) string {
+	t.Helper()
+
+	s, err := RandomString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestExchangeChecksVerifier(t *testing.T) {
+	p, iss := newTestProvider(t)
+	ctx := context.Background()
+	nonce := randomString(t)
+	verifier := randomString(t)
+
+	authURL, err := p.AuthCodeURL(ctx, "state", nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := iss.Authorize(t, authURL, iss.Sign(t, iss.Claims(nonce)))
+
+	if _, err := p.Exchange(ctx, code, randomString(t), nonce); !errors.Is(err, ErrExchange) {
+		t.Fatalf("another verifier: err = %v, want ErrExchange", err)
+	}
+
+	code = iss.Authorize(t, authURL, iss.Sign(t, iss.Claims(nonce)))
+	claims, err := p.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		t.Fatalf("the verifier of the challenge: %v", err)
+	}
+	if claims.Subject != "subject-1" || claims.Email != "ada@example.com" || !claims.EmailVerified {
+		t.Errorf("claims = %+v", claims)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	p, iss := newTestProvider(t)
+	ctx := context.Background()
+	meta, err := p.discover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const nonce = "the-nonce"
+	now := time.Now()
+	tests := []struct {
+		name  string
+		edit  func(claims map[string]any)
+		valid bool
+	}{
+		{name: "valid", edit: func(map[string]any) {}, valid: true},
+		{name: "other nonce", edit: func(c map[string]any) { c["nonce"] = "another-nonce" }},
+		{name: "no nonce", edit: func(c map[string]any) { delete(c, "nonce") }},
+		{name: "other issuer", edit: func(c map[string]any) { c["iss"] = "https://evil.example.com" }},
+		{name: "other audience", edit: func(c map[string]any) { c["aud"] = "another-client" }},
+		{name: "audience list with us", edit: func(c map[string]any) {
+			c["aud"] = []string{testClientID}
+		}, valid: true},
+		{name: "audiences without azp", edit: func(c map[string]any) {
+			c["aud"] = []string{testClientID, "another-client"}
+		}},
+		{name: "audiences with another azp", edit: func(c map[string]any) {
+			c["aud"] = []string{testClientID, "another-client"}
+			c["azp"] = "another-client"
+		}},
+		{name: "audiences with our azp", edit: func(c map[string]any) {
+			c["aud"] = []string{testClientID, "another-client"}
+			c["azp"] = testClientID
+		}, valid: true},
+		{name: "expired within leeway", edit: func(c map[string]any) {
+			c["exp"] = now.Add(-30 * time.Second).Unix()
+		}, valid: true},
+		{name: "expired beyond leeway", edit: func(c map[string]any) {
+			c["exp"] = now.Add(-2 * time.Minute).Unix()
+		}},
+		{name: "issued ahead within leeway", edit: func(c map[string]any) {
+			c["iat"] = now.Add(30 * time.Second).Unix()
+		}, valid: true},
+		{name: "issued ahead beyond leeway", edit: func(c map[string]any) {
+			c["iat"] = now.Add(2 * time.Minute).Unix()
+		}},
+		{name: "no subject", edit: func(c map[string]any) { delete(c, "sub") }},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			claims := iss.Claims(nonce)
+			tt.edit(claims)
+
+			_, err := p.verify(ctx, meta, iss.Sign(t, claims), nonce)
+			if tt.valid && err != nil {
+				t.Fatalf("err = %v, want a valid token", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidIDToken) {
+				t.Fatalf("err = %v, want ErrInvalidIDToken", err)
+			}
+		})
+	}
+}
+
+func TestVerifyPinsAlgorithm(t *testing.T) {
+	p, iss := newTestProvider(t)
+	ctx := context.Background()
+	meta, err := p.discover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alg := range []string{"none", "HS256", "RS384", "ES256"} {
+		raw := iss.SignWith(t, map[string]any{"alg": alg, "kid": "key-1"}, iss.Claims("nonce"))
+		if _, err := p.verify(ctx, meta, raw, "nonce"); !errors.Is(err, ErrInvalidIDToken) {
+			t.Errorf("alg %s: err = %v, want ErrInvalidIDToken", alg, err)
+		}
+	}
+
+	if _, err := p.verify(ctx, meta, iss.Sign(t, iss.Claims("nonce")), "nonce"); err != nil {
+		t.Fatalf("rs256 under a published key: %v", err)
+	}
+}
+
+func TestVerifyEmailVerified(t *testing.T) {
+	p, iss := newTestProvider(t)
+	ctx := context.Background()
+	meta, err := p.discover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Some providers send the flag as a string.
+	tests := []struct {
+		value any
+		want  bool
+	}{
+		{true, true},
+		{"true", true},
+		{false, false},
+		{"false", false},
+		{nil, false},
+	}
+
+	for _, tt := range tests {
+		claims := iss.Claims("nonce")
+		if tt.value == nil {
+			delete(claims, "email_verified")
+		} else {
+			claims["email_verified"] = tt.value
+		}
+
+		got, err := p.verify(ctx, meta, iss.Sign(t, claims), "nonce")
+		if err != nil {
+			t.Errorf("email_verified %v: %v", tt.value, err)
+			continue
+		}
+		if got.EmailVerified != tt.want {
+			t.Errorf("email_verified %v: EmailVerified = %v, want %v", tt.value, got.EmailVerified, tt.want)
+		}
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	p, iss := newTestProvider(t)
+	ctx := context.Background()
+	meta, err := p.discover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.verify(ctx, meta, iss.Sign(t, iss.Claims("nonce")), "nonce"); err != nil {
+		t.Fatal(err)
+	}
+	if n := iss.JWKSRequests(); n != 1 {
+		t.Fatalf("keys fetched %d times, want once", n)
+	}
+
+	// Tokens naming a key the cache doesn't know don't refetch the keys more
+	// than once per refreshInterval, not even a key that does exist.
+	iss.RotateKey(t)
+	rotated := iss.Sign(t, iss.Claims("nonce"))
+	for i := 0; i < 3; i++ {
+		if _, err := p.verify(ctx, meta, rotated, "nonce"); !errors.Is(err, ErrInvalidIDToken) {
+			t.Fatalf("err = %v, want ErrInvalidIDToken while throttled", err)
+		}
+	}
+	if n := iss.JWKSRequests(); n != 1 {
+		t.Fatalf("keys fetched %d times while throttled, want once", n)
+	}
+
+	p.keys.mu.Lock()
+	p.keys.fetchedAt = time.Now().Add(-refreshInterval)
+	p.keys.mu.Unlock()
+
+	if _, err := p.verify(ctx, meta, rotated, "nonce"); err != nil {
+		t.Fatalf("rotated key once the interval passed: %v", err)
+	}
+	if _, err := p.verify(ctx, meta, rotated, "nonce"); err != nil {
+		t.Fatal(err)
+	}
+	if n := iss.JWKSRequests(); n != 2 {
+		t.Fatalf("keys fetched %d times, want twice", n)
+	}
+}
@@ -0,0 +1,230 @@
+// Package oidctest runs an OpenID Connect provider in process, for tests of
+// the sign-in flow. It serves the discovery document, the signing keys and a
+// token endpoint checking PKCE, and signs whatever ID tokens a test asks for.
+package oidctest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+var encoding = base64.RawURLEncoding
+
+// Issuer is a provider serving on a local address.
+type Issuer struct {
+	*httptest.Server
+	ClientID string
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	current string
+	grants  map[string]grant
+	// jwksRequests counts the requests for the signing keys.
+	jwksRequests int
+}
+
+type grant struct {
+	challenge string
+	idToken   string
+}
+
+// NewIssuer starts a provider with a single signing key. It is shut down
+// when the test ends.
+func NewIssuer(t testing.TB, clientID string) *Issuer {
+	t.Helper()
+
+	iss := &Issuer{ClientID: clientID, keys: map[string]*rsa.PrivateKey{}, grants: map[string]grant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", iss.discovery)
+	mux.HandleFunc("/jwks", iss.jwks)
+	mux.HandleFunc("/token", iss.token)
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+
+	iss.RotateKey(t)
+	return iss
+}
+
+// RotateKey publishes a new key next to the previous ones and signs with it
+// from now on. It returns the ID of the new key.
+func (i *Issuer) RotateKey(t testing.TB) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	kid := fmt.Sprintf("key-%d", len(i.keys)+1)
+	i.keys[kid] = key
+	i.current = kid
+	return kid
+}
+
+// JWKSRequests returns how many times the signing keys were fetched.
+func (i *Issuer) JWKSRequests() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.jwksRequests
+}
+
+// Claims returns the claims of a valid ID token for nonce, issued now.
+func (i *Issuer) Claims(nonce string) map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"iss":            i.URL,
+		"sub":            "subject-1",
+		"aud":            i.ClientID,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          nonce,
+		"email":          "ada@example.com",
+		"email_verified": true,
+		"name":           "Ada Lovelace",
+	}
+}
+
+// Sign signs claims with the current key, under its key ID.
+func (i *Issuer) Sign(t testing.TB, claims map[string]any) string {
+	t.Helper()
+
+	i.mu.Lock()
+	kid := i.current
+	i.mu.Unlock()
+
+	return i.SignWith(t, map[string]any{"alg": "RS256", "typ": "JWT", "kid": kid}, claims)
+}
+
+// SignWith signs claims under header with the key it names, or with a key
+// the issuer never published when it names none it knows. The algorithm of
+// the header is left as is, the signature is always RS256.
+func (i *Issuer) SignWith(t testing.TB, header map[string]any, claims map[string]any) string {
+	t.Helper()
+
+	i.mu.Lock()
+	kid, _ := header["kid"].(string)
+	key, ok := i.keys[kid]
+	i.mu.Unlock()
+
+	if !ok {
+		var err error
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	signed := segment(t, header) + "." + segment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + encoding.EncodeToString(sig)
+}
+
+// Authorize plays the user consenting on the page authURL points at. It
+// returns the code the provider sends back, which the token endpoint trades
+// for idToken when presented with the verifier of the challenge in authURL.
+func (i *Issuer) Authorize(t testing.TB, authURL string, idToken string) string {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if method := query.Get("code_challenge_method"); method != "S256" {
+		t.Fatalf("code_challenge_method = %q, want S256", method)
+	}
+	if query.Get("client_id") != i.ClientID {
+		t.Fatalf("client_id = %q, want %q", query.Get("client_id"), i.ClientID)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	code := fmt.Sprintf("code-%d", len(i.grants)+1)
+	i.grants[code] = grant{challenge: query.Get("code_challenge"), idToken: idToken}
+	return code
+}
+
+func (i *Issuer) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                           i.URL,
+		"authorization_endpoint":           i.URL + "/authorize",
+		"token_endpoint":                   i.URL + "/token",
+		"jwks_uri":                         i.URL + "/jwks",
+		"code_challenge_methods_supported": []string{"S256"},
+	})
+}
+
+func (i *Issuer) jwks(w http.ResponseWriter, r *http.Request) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.jwksRequests++
+	keys := []map[string]string{}
+	for kid, key := range i.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": kid,
+			"n":   encoding.EncodeToString(key.N.Bytes()),
+			"e":   encoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"keys": keys})
+}
+
+// token answers like providers do, with a 400 invalid_grant for an unknown
+// code or a verifier that doesn't match the challenge. Codes are single use.
+func (i *Issuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	i.mu.Lock()
+	g, ok := i.grants[r.PostForm.Get("code")]
+	delete(i.grants, r.PostForm.Get("code"))
+	i.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || encoding.EncodeToString(sum[:]) != g.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "code or verifier is wrong"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"access_token": "access", "token_type": "Bearer", "id_token": g.idToken})
+}
+
+func segment(t testing.TB, v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoding.EncodeToString(data)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,31 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// challengeMethod is the only PKCE method used, "plain" would hand the
+// verifier to anyone reading the authorization request.
+const challengeMethod = "S256"
+
+var encoding = base64.RawURLEncoding
+
+// RandomString returns 32 random bytes, URL safe encoded. It makes states,
+// nonces and code verifiers, the latter 43 characters long as RFC 7636 asks
+// at least.
+func RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("oidc: failed to read random bytes: %w", err)
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Challenge derives the S256 code challenge sent for verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return encoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,276 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// leeway absorbs the clock drift between us and the provider.
+const leeway = time.Minute
+
+// refreshInterval is how often the key set may be fetched again for a key ID
+// it doesn't know, so tokens naming made up keys can't hammer the provider.
+const refreshInterval = time.Minute
+
+// Claims are what an ID token says about the user.
+type Claims struct {
+	// Subject identifies the user at the provider, unlike the e-mail it
+	// never changes.
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type idTokenClaims struct {
+	Issuer        string   `json:"iss"`
+	Subject       string   `json:"sub"`
+	Audience      audience `json:"aud"`
+	AuthorizedBy  string   `json:"azp"`
+	ExpiresAt     int64    `json:"exp"`
+	IssuedAt      int64    `json:"iat"`
+	Nonce         string   `json:"nonce"`
+	Email         string   `json:"email"`
+	EmailVerified flag     `json:"email_verified"`
+	Name          string   `json:"name"`
+}
+
+// audience is a single audience or a list of them, both are allowed.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*a = audience{one}
+		return nil
+	}
+
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+// flag is a boolean some providers send as a string.
+type flag bool
+
+func (f *flag) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case `true`, `"true"`:
+		*f = true
+	case `false`, `"false"`, `null`:
+		*f = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+// verify checks the signature of the ID token raw against the provider's
+// keys, that it was issued by the provider for us and for this sign-in, and
+// that it has not expired.
+func (p *Provider) verify(ctx context.Context, meta *metadata, raw string, nonce string) (Claims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return Claims{}, fmt.Errorf("%w: malformed token", ErrInvalidIDToken)
+	}
+
+	var header idTokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed header", ErrInvalidIDToken)
+	}
+
+	// The algorithm is pinned rather than taken from the header, which is
+	// how "none" and HMAC-with-the-public-key tokens get accepted.
+	if header.Alg != "RS256" {
+		return Claims{}, fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidIDToken, header.Alg)
+	}
+
+	sig, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed signature", ErrInvalidIDToken)
+	}
+
+	key, err := p.keys.key(ctx, header.Kid)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return Claims{}, fmt.Errorf("%w: bad signature", ErrInvalidIDToken)
+	}
+
+	var c idTokenClaims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed claims", ErrInvalidIDToken)
+	}
+
+	if c.Issuer != meta.Issuer {
+		return Claims{}, fmt.Errorf("%w: issued by %q", ErrInvalidIDToken, c.Issuer)
+	}
+
+	if !contains(c.Audience, p.cfg.ClientID) {
+		return Claims{}, fmt.Errorf("%w: not issued for this client", ErrInvalidIDToken)
+	}
+
+	if len(c.Audience) > 1 && c.AuthorizedBy != p.cfg.ClientID {
+		return Claims{}, fmt.Errorf("%w: authorized party is %q", ErrInvalidIDToken, c.AuthorizedBy)
+	}
+
+	now := time.Now()
+	if !now.Add(-leeway).Before(time.Unix(c.ExpiresAt, 0)) {
+		return Claims{}, fmt.Errorf("%w: expired", ErrInvalidIDToken)
+	}
+
+	if time.Unix(c.IssuedAt, 0).After(now.Add(leeway)) {
+		return Claims{}, fmt.Errorf("%w: issued in the future", ErrInvalidIDToken)
+	}
+
+	if c.Nonce == "" || c.Nonce != nonce {
+		return Claims{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	if c.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+
+	return Claims{
+		Subject:       c.Subject,
+		Email:         c.Email,
+		EmailVerified: bool(c.EmailVerified),
+		Name:          c.Name,
+	}, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := encoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// keySet caches the signing keys the provider publishes. Providers rotate
+// their keys by publishing the new one first, so a key ID that isn't known
+// yet triggers a refresh.
+type keySet struct {
+	client *http.Client
+	uri    string
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func newKeySet(client *http.Client, uri string) *keySet {
+	return &keySet{client: client, uri: uri}
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// key returns the RSA key published under kid. An empty kid is only accepted
+// when the provider publishes a single key.
+func (s *keySet) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	if !s.fetchedAt.IsZero() && time.Since(s.fetchedAt) < refreshInterval {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, kid)
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, kid)
+}
+
+func (s *keySet) lookup(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok && kid != ""
+}
+
+func (s *keySet) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.uri, nil)
+	if err != nil {
+		return fmt.Errorf("oidc: failed to build jwks request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := doJSON(s.client, req, &set); err != nil {
+		return fmt.Errorf("oidc: failed to fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		key, err := rsaKey(k)
+		if err != nil {
+			return fmt.Errorf("oidc: invalid key %q in jwks: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	return nil
+}
+
+func rsaKey(k jwk) (*rsa.PublicKey, error) {
+	n, err := encoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+
+	e, err := encoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) < 256 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("unsupported key size or exponent")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
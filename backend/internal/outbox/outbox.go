@@ -0,0 +1,202 @@
+// Package outbox delivers the e-mails that pgstore enqueues in the same
+// transaction as the domain change that triggered them.
+package outbox
+
+import (
+	"SwallowGo/internal/pgstore"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type store interface {
+	ClaimOutboxMessages(ctx context.Context, arg pgstore.ClaimOutboxMessagesParams) ([]pgstore.ClaimOutboxMessagesRow, error)
+	MarkOutboxMessageSent(ctx context.Context, id uuid.UUID) error
+	RetryOutboxMessage(ctx context.Context, arg pgstore.RetryOutboxMessageParams) error
+	DeadLetterOutboxMessage(ctx context.Context, arg pgstore.DeadLetterOutboxMessageParams) error
+}
+
+type mailer interface {
+	SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error
+	ReSendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error
+	SendConfirmTripEmailToTripinvitation(ctx context.Context, tripID uuid.UUID, participantID uuid.UUID) error
+	SendParticipantRemovedEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error
+	SendInvitationRevokedEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error
+	SendTripCancelledEmail(ctx context.Context, tripID uuid.UUID, participantID uuid.UUID) error
+	SendMagicLinkEmail(ctx context.Context, email string) error
+	SendOwnershipTransferEmail(ctx context.Context, tripID uuid.UUID, participantID uuid.UUID) error
+	SendOwnershipReceivedEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error
+	SendOwnershipHandedOverEmail(ctx context.Context, tripID uuid.UUID, email string, name string) error
+}
+
+type Config struct {
+	// PollInterval is how long the dispatcher sleeps when there is nothing to send.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of messages claimed at once.
+	BatchSize int32
+	// Lease is how long a claimed message stays invisible to other
+	// dispatchers. Messages of a dispatcher that dies mid-send are picked up
+	// again once their lease runs out.
+	Lease time.Duration
+	// SendTimeout bounds a single delivery attempt.
+	SendTimeout time.Duration
+	// MaxAttempts is the number of deliveries tried before a message is dead-lettered.
+	MaxAttempts int32
+	// BaseBackoff is doubled after every failed attempt, up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+var DefaultConfig = Config{
+	PollInterval: 2 * time.Second,
+	BatchSize:    20,
+	Lease:        2 * time.Minute,
+	SendTimeout:  30 * time.Second,
+	MaxAttempts:  8,
+	BaseBackoff:  5 * time.Second,
+	MaxBackoff:   30 * time.Minute,
+}
+
+type Dispatcher struct {
+	store  store
+	mailer mailer
+	logger *zap.Logger
+	cfg    Config
+}
+
+func NewDispatcher(pool *pgxpool.Pool, mailer mailer, logger *zap.Logger, cfg Config) Dispatcher {
+	return Dispatcher{pgstore.New(pool), mailer, logger.Named("outbox"), cfg}
+}
+
+// Run claims and delivers due messages until ctx is cancelled. Messages that
+// were already claimed when ctx is cancelled are still delivered before Run
+// returns, so waiting for Run is enough to drain the dispatcher on shutdown.
+func (d Dispatcher) Run(ctx context.Context) {
+	for {
+		n, err := d.dispatchBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.logger.Error("failed to dispatch outbox batch", zap.Error(err))
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if n == d.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+func (d Dispatcher) dispatchBatch(ctx context.Context) (int32, error) {
+	messages, err := d.store.ClaimOutboxMessages(ctx, pgstore.ClaimOutboxMessagesParams{
+		LeaseSeconds: d.cfg.Lease.Seconds(),
+		BatchSize:    d.cfg.BatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("outbox: failed to claim messages: %w", err)
+	}
+
+	// The batch is ours now, finish it even if shutdown was requested.
+	ctx = context.WithoutCancel(ctx)
+	for _, message := range messages {
+		d.dispatch(ctx, message)
+	}
+
+	return int32(len(messages)), nil
+}
+
+func (d Dispatcher) dispatch(ctx context.Context, message pgstore.ClaimOutboxMessagesRow) {
+	logger := d.logger.With(
+		zap.String("message_id", message.ID.String()),
+		zap.String("kind", message.Kind),
+		zap.Int32("attempt", message.Attempts),
+	)
+
+	sendErr := d.send(ctx, message)
+	if sendErr == nil {
+		if err := d.store.MarkOutboxMessageSent(ctx, message.ID); err != nil {
+			logger.Error("failed to mark outbox message as sent", zap.Error(err))
+		}
+		return
+	}
+
+	lastError := pgtype.Text{String: sendErr.Error(), Valid: true}
+	if message.Attempts >= d.cfg.MaxAttempts {
+		logger.Error("dead-lettering outbox message", zap.Error(sendErr))
+		if err := d.store.DeadLetterOutboxMessage(ctx, pgstore.DeadLetterOutboxMessageParams{
+			LastError: lastError,
+			ID:        message.ID,
+		}); err != nil {
+			logger.Error("failed to dead-letter outbox message", zap.Error(err))
+		}
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(d.backoff(message.Attempts))
+	logger.Warn("failed to send outbox message, retrying", zap.Error(sendErr), zap.Time("next_attempt_at", nextAttemptAt))
+	if err := d.store.RetryOutboxMessage(ctx, pgstore.RetryOutboxMessageParams{
+		NextAttemptAt: pgtype.Timestamptz{Valid: true, Time: nextAttemptAt},
+		LastError:     lastError,
+		ID:            message.ID,
+	}); err != nil {
+		logger.Error("failed to reschedule outbox message", zap.Error(err))
+	}
+}
+
+func (d Dispatcher) send(ctx context.Context, message pgstore.ClaimOutboxMessagesRow) error {
+	var payload pgstore.OutboxPayload
+	if err := json.Unmarshal(message.Payload, &payload); err != nil {
+		return fmt.Errorf("outbox: invalid payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.SendTimeout)
+	defer cancel()
+
+	switch pgstore.OutboxKind(message.Kind) {
+	case pgstore.OutboxConfirmTripOwner:
+		return d.mailer.SendConfirmTripEmailToTripOwner(ctx, payload.TripID)
+	case pgstore.OutboxReconfirmTripOwner:
+		return d.mailer.ReSendConfirmTripEmailToTripOwner(ctx, payload.TripID)
+	case pgstore.OutboxTripInvitation:
+		return d.mailer.SendConfirmTripEmailToTripinvitation(ctx, payload.TripID, payload.ParticipantID)
+	case pgstore.OutboxParticipantRemoved:
+		return d.mailer.SendParticipantRemovedEmail(ctx, payload.TripID, payload.Email, payload.Name)
+	case pgstore.OutboxInvitationRevoked:
+		return d.mailer.SendInvitationRevokedEmail(ctx, payload.TripID, payload.Email, payload.Name)
+	case pgstore.OutboxTripCancelled:
+		return d.mailer.SendTripCancelledEmail(ctx, payload.TripID, payload.ParticipantID)
+	case pgstore.OutboxMagicLink:
+		return d.mailer.SendMagicLinkEmail(ctx, payload.Email)
+	case pgstore.OutboxOwnershipTransfer:
+		return d.mailer.SendOwnershipTransferEmail(ctx, payload.TripID, payload.ParticipantID)
+	case pgstore.OutboxOwnershipReceived:
+		return d.mailer.SendOwnershipReceivedEmail(ctx, payload.TripID, payload.Email, payload.Name)
+	case pgstore.OutboxOwnershipHandedOver:
+		return d.mailer.SendOwnershipHandedOverEmail(ctx, payload.TripID, payload.Email, payload.Name)
+	default:
+		return fmt.Errorf("outbox: unknown message kind %q", message.Kind)
+	}
+}
+
+// backoff returns the delay before the next attempt, given how many attempts
+// were already made.
+func (d Dispatcher) backoff(attempts int32) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := int32(1); i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
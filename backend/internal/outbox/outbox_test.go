@@ -0,0 +1,168 @@
+package outbox
+
+import (
+	"SwallowGo/internal/pgstore"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// fakeStore records what the dispatcher did with each message.
+type fakeStore struct {
+	store
+
+	sent        []uuid.UUID
+	retried     []pgstore.RetryOutboxMessageParams
+	deadLetters []pgstore.DeadLetterOutboxMessageParams
+}
+
+func (s *fakeStore) MarkOutboxMessageSent(_ context.Context, id uuid.UUID) error {
+	s.sent = append(s.sent, id)
+	return nil
+}
+
+func (s *fakeStore) RetryOutboxMessage(_ context.Context, arg pgstore.RetryOutboxMessageParams) error {
+	s.retried = append(s.retried, arg)
+	return nil
+}
+
+func (s *fakeStore) DeadLetterOutboxMessage(_ context.Context, arg pgstore.DeadLetterOutboxMessageParams) error {
+	s.deadLetters = append(s.deadLetters, arg)
+	return nil
+}
+
+// fakeMailer fails every delivery with err, nil delivers. Kinds the tests
+// don't send fall through to the nil interface and panic.
+type fakeMailer struct {
+	mailer
+
+	err   error
+	calls []uuid.UUID
+}
+
+func (m *fakeMailer) SendConfirmTripEmailToTripOwner(_ context.Context, tripID uuid.UUID) error {
+	m.calls = append(m.calls, tripID)
+	return m.err
+}
+
+var testConfig = Config{
+	SendTimeout: time.Second,
+	MaxAttempts: 3,
+	BaseBackoff: 5 * time.Second,
+	MaxBackoff:  time.Minute,
+}
+
+func TestBackoff(t *testing.T) {
+	d := Dispatcher{cfg: testConfig}
+
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{0, 5 * time.Second},
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{3, 20 * time.Second},
+		{4, 40 * time.Second},
+		{5, time.Minute},
+		{6, time.Minute},
+		{1000, time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	tripID := uuid.New()
+	payload, err := json.Marshal(pgstore.OutboxPayload{TripID: tripID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendErr := errors.New("smtp: 421 try again later")
+
+	tests := []struct {
+		name     string
+		kind     pgstore.OutboxKind
+		payload  []byte
+		attempts int32
+		sendErr  error
+		// want is what became of the message: sent, retried or dead-lettered.
+		want      string
+		wantError string
+		wantCalls int
+	}{
+		{name: "delivered", kind: pgstore.OutboxConfirmTripOwner, payload: payload, attempts: 1, want: "sent", wantCalls: 1},
+		{name: "delivered on the last attempt", kind: pgstore.OutboxConfirmTripOwner, payload: payload, attempts: 3, want: "sent", wantCalls: 1},
+		{name: "failed", kind: pgstore.OutboxConfirmTripOwner, payload: payload, attempts: 1, sendErr: sendErr, want: "retried", wantError: sendErr.Error(), wantCalls: 1},
+		{name: "failed before the last attempt", kind: pgstore.OutboxConfirmTripOwner, payload: payload, attempts: 2, sendErr: sendErr, want: "retried", wantError: sendErr.Error(), wantCalls: 1},
+		{name: "failed on the last attempt", kind: pgstore.OutboxConfirmTripOwner, payload: payload, attempts: 3, sendErr: sendErr, want: "dead-lettered", wantError: sendErr.Error(), wantCalls: 1},
+		{name: "unknown kind", kind: "postcard", payload: payload, attempts: 1, want: "retried", wantError: `unknown message kind "postcard"`},
+		{name: "unknown kind on the last attempt", kind: "postcard", payload: payload, attempts: 3, want: "dead-lettered", wantError: `unknown message kind "postcard"`},
+		{name: "invalid payload", kind: pgstore.OutboxConfirmTripOwner, payload: []byte("{"), attempts: 1, want: "retried", wantError: "invalid payload"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fs := &fakeStore{}
+			fm := &fakeMailer{err: tt.sendErr}
+			d := Dispatcher{store: fs, mailer: fm, logger: zap.NewNop(), cfg: testConfig}
+			message := pgstore.ClaimOutboxMessagesRow{ID: uuid.New(), Kind: string(tt.kind), Payload: tt.payload, Attempts: tt.attempts}
+
+			before := time.Now()
+			d.dispatch(context.Background(), message)
+
+			if len(fm.calls) != tt.wantCalls {
+				t.Fatalf("mailer called %d times, want %d", len(fm.calls), tt.wantCalls)
+			}
+			if tt.wantCalls > 0 && fm.calls[0] != tripID {
+				t.Errorf("mailer called for trip %s, want %s", fm.calls[0], tripID)
+			}
+
+			var got []string
+			if len(fs.sent) > 0 {
+				got = append(got, "sent")
+			}
+			if len(fs.retried) > 0 {
+				got = append(got, "retried")
+			}
+			if len(fs.deadLetters) > 0 {
+				got = append(got, "dead-lettered")
+			}
+			if len(got) != 1 || got[0] != tt.want {
+				t.Fatalf("message %v, want only %s", got, tt.want)
+			}
+
+			switch tt.want {
+			case "sent":
+				if fs.sent[0] != message.ID {
+					t.Errorf("marked %s as sent, want %s", fs.sent[0], message.ID)
+				}
+			case "retried":
+				retry := fs.retried[0]
+				if retry.ID != message.ID || !strings.Contains(retry.LastError.String, tt.wantError) {
+					t.Errorf("retry = %+v, want %s with error %q", retry, message.ID, tt.wantError)
+				}
+				delay := d.backoff(tt.attempts)
+				if at := retry.NextAttemptAt.Time; at.Before(before.Add(delay)) || at.After(time.Now().Add(delay)) {
+					t.Errorf("next attempt at %v, want %v from now", at, delay)
+				}
+			case "dead-lettered":
+				dead := fs.deadLetters[0]
+				if dead.ID != message.ID || !strings.Contains(dead.LastError.String, tt.wantError) {
+					t.Errorf("dead letter = %+v, want %s with error %q", dead, message.ID, tt.wantError)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,39 @@ import (
 	"context"
 )
 
+// iteratorForEnqueueOutboxMessages implements pgx.CopyFromSource.
+type iteratorForEnqueueOutboxMessages struct {
+	rows                 []EnqueueOutboxMessagesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForEnqueueOutboxMessages) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForEnqueueOutboxMessages) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].Kind,
+		r.rows[0].Payload,
+	}, nil
+}
+
+func (r iteratorForEnqueueOutboxMessages) Err() error {
+	return nil
+}
+
+func (q *Queries) EnqueueOutboxMessages(ctx context.Context, arg []EnqueueOutboxMessagesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"outbox"}, []string{"kind", "payload"}, &iteratorForEnqueueOutboxMessages{rows: arg})
+}
+
 // iteratorForInviteParticipantsToTrip implements pgx.CopyFromSource.
 type iteratorForInviteParticipantsToTrip struct {
 	rows                 []InviteParticipantsToTripParams
@@ -31,6 +64,7 @@ func (r iteratorForInviteParticipantsToTrip) Values() ([]interface{}, error) {
 	return []interface{}{
 		r.rows[0].TripID,
 		r.rows[0].Email,
+		r.rows[0].Name,
 	}, nil
 }
 
@@ -39,5 +73,5 @@ func (r iteratorForInviteParticipantsToTrip) Err() error {
 }
 
 func (q *Queries) InviteParticipantsToTrip(ctx context.Context, arg []InviteParticipantsToTripParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email", "name"}, &iteratorForInviteParticipantsToTrip{rows: arg})
 }
@@ -0,0 +1,60 @@
+package pgstore
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// participantEmailKey is the unique index allowing an e-mail once per trip.
+const participantEmailKey = "participants_trip_id_email_key"
+
+var (
+	ErrInvalidConfirmationToken = errors.New("pgstore: confirmation token is invalid, expired or already used")
+	ErrTripClosed               = errors.New("pgstore: trip can no longer be changed")
+	ErrInvalidLoginToken        = errors.New("pgstore: login token is invalid, expired or already used")
+	ErrUnverifiedEmail          = errors.New("pgstore: identity provider has not verified the e-mail")
+	ErrParticipantNotConfirmed  = errors.New("pgstore: participant has not accepted the invitation")
+	ErrNoOwnershipTransfer      = errors.New("pgstore: no pending ownership transfer to the participant")
+)
+
+// DuplicateParticipantError is returned when an e-mail is invited to a trip it
+// was already invited to. E-mails are compared case-insensitively.
+type DuplicateParticipantError struct {
+	ParticipantID uuid.UUID
+}
+
+func (e *DuplicateParticipantError) Error() string {
+	return "pgstore: e-mail already invited to trip as participant " + e.ParticipantID.String()
+}
+
+// ActivityOutsideTripError is returned when an activity is planned or moved to
+// a time before the trip starts or after it ends.
+type ActivityOutsideTripError struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+func (e *ActivityOutsideTripError) Error() string {
+	return "pgstore: activity must occur between " + e.StartsAt.Format(time.RFC3339) + " and " + e.EndsAt.Format(time.RFC3339)
+}
+
+// OrphanedActivitiesError is returned when new trip dates would leave
+// activities that used to fit in the trip outside of it.
+type OrphanedActivitiesError struct {
+	Activities []Activity
+}
+
+func (e *OrphanedActivitiesError) Error() string {
+	return fmt.Sprintf("pgstore: %d activities would fall outside of the trip", len(e.Activities))
+}
+
+// isUniqueViolation reports whether err is Postgres refusing a row that
+// would break the unique constraint or index named constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
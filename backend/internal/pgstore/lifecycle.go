@@ -0,0 +1,84 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// tripTransitions lists, for every status, the statuses a trip may enter it
+// from. The time-based ones are also encoded in StartDueTrips and
+// CompleteDueTrips.
+var tripTransitions = map[TripStatus][]TripStatus{
+	TripStatusPendingConfirmation: {TripStatusDraft},
+	// The owner may follow the link before its delivery was recorded.
+	TripStatusConfirmed:  {TripStatusDraft, TripStatusPendingConfirmation},
+	TripStatusInProgress: {TripStatusConfirmed},
+	TripStatusCompleted:  {TripStatusInProgress},
+	TripStatusCancelled:  {TripStatusConfirmed, TripStatusInProgress},
+	TripStatusArchived:   {TripStatusCompleted, TripStatusCancelled},
+}
+
+// InvalidTransitionError is returned when a trip can't enter a status from the
+// one it is in.
+type InvalidTransitionError struct {
+	From TripStatus
+	To   TripStatus
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("pgstore: trip can't go from %s to %s", e.From, e.To)
+}
+
+// CanTransition reports whether a trip may go from one status to another.
+func CanTransition(from TripStatus, to TripStatus) bool {
+	return slices.Contains(tripTransitions[to], from)
+}
+
+// Open reports whether a trip in this status can still be edited and take new
+// participants.
+func (s TripStatus) Open() bool {
+	switch s {
+	case TripStatusDraft, TripStatusPendingConfirmation, TripStatusConfirmed:
+		return true
+	default:
+		return false
+	}
+}
+
+// InvitationsSent reports whether the owner confirmed the trip at some point,
+// which is when participants are e-mailed their invitations.
+func (s TripStatus) InvitationsSent() bool {
+	return s != TripStatusDraft && s != TripStatusPendingConfirmation
+}
+
+// TransitionTrip moves a trip to the given status and records when it
+// happened. It returns pgx.ErrNoRows when the trip does not exist and an
+// *InvalidTransitionError when the move is not allowed. Called on a Queries
+// bound to a transaction, the move commits with it.
+func (q *Queries) TransitionTrip(ctx context.Context, tripID uuid.UUID, to TripStatus) error {
+	from := make([]string, len(tripTransitions[to]))
+	for i, status := range tripTransitions[to] {
+		from[i] = string(status)
+	}
+
+	n, err := q.UpdateTripStatus(ctx, UpdateTripStatusParams{
+		ID:           tripID,
+		ToStatus:     to,
+		FromStatuses: from,
+	})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to update status for TransitionTrip: %w", err)
+	}
+	if n == 1 {
+		return nil
+	}
+
+	trip, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for TransitionTrip: %w", err)
+	}
+	return &InvalidTransitionError{From: trip.Status, To: to}
+}
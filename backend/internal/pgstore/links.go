@@ -0,0 +1,47 @@
+package pgstore
+
+import (
+	"SwallowGo/internal/api/spec"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReplaceTripLink overwrites every editable field of a link, clearing the
+// optional ones params leaves out. It returns pgx.ErrNoRows when the link does
+// not belong to the trip.
+func (q *Queries) ReplaceTripLink(ctx context.Context, params spec.UpdateLinkRequest, tripID uuid.UUID, linkID uuid.UUID) error {
+	n, err := q.UpdateTripLink(ctx, UpdateTripLinkParams{
+		Title:       params.Title,
+		Url:         params.URL,
+		Description: optionalText(params.Description),
+		Category:    optionalLinkCategory(params.Category),
+		SortOrder:   sortOrder(params.SortOrder),
+		ID:          linkID,
+		TripID:      tripID,
+	})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to update link for ReplaceTripLink: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("pgstore: failed to update link for ReplaceTripLink: %w", pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+func optionalLinkCategory(c *spec.LinkCategory) NullLinkCategory {
+	if c == nil {
+		return NullLinkCategory{}
+	}
+	return NullLinkCategory{LinkCategory: LinkCategory(c.ToValue()), Valid: true}
+}
+
+func sortOrder(n *int32) int32 {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
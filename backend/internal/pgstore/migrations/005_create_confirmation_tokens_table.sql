@@ -0,0 +1,26 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS confirmation_tokens (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "token_hash"        BYTEA                       NOT NULL    UNIQUE,
+    "purpose"           VARCHAR(32)                 NOT NULL,
+    "trip_id"           uuid                        NOT NULL,
+    "participant_id"    uuid,
+    "expires_at"        TIMESTAMPTZ                 NOT NULL,
+    "used_at"           TIMESTAMPTZ,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS confirmation_tokens;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS outbox (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "kind"              VARCHAR(64)                 NOT NULL,
+    "payload"           JSONB                       NOT NULL,
+    "status"            VARCHAR(16)                 NOT NULL    DEFAULT 'pending',
+    "attempts"          INTEGER                     NOT NULL    DEFAULT 0,
+    "next_attempt_at"   TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+    "last_error"        TEXT,
+    "created_at"        TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+    "sent_at"           TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (next_attempt_at) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS outbox;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,16 @@
+-- Write your migrate up statements here
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "name"     VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "phone"    VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS "notes"    TEXT;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "notes",
+    DROP COLUMN IF EXISTS "phone",
+    DROP COLUMN IF EXISTS "name";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
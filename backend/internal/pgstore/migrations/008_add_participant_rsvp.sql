@@ -0,0 +1,38 @@
+-- Write your migrate up statements here
+
+CREATE TYPE rsvp_status AS ENUM ('pending', 'accepted', 'declined', 'maybe');
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "rsvp_status"     rsvp_status     NOT NULL    DEFAULT 'pending',
+    ADD COLUMN IF NOT EXISTS "responded_at"    TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "rsvp_comment"    TEXT;
+
+UPDATE participants
+SET
+    "rsvp_status" = 'accepted'
+WHERE
+    is_confirmed;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "is_confirmed";
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "is_confirmed" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE participants
+SET
+    "is_confirmed" = true
+WHERE
+    rsvp_status = 'accepted';
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "rsvp_comment",
+    DROP COLUMN IF EXISTS "responded_at",
+    DROP COLUMN IF EXISTS "rsvp_status";
+
+DROP TYPE IF EXISTS rsvp_status;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
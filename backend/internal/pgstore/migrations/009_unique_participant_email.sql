@@ -0,0 +1,27 @@
+-- Write your migrate up statements here
+
+-- Keep one row per (trip, e-mail), preferring the one that answered the
+-- invitation most recently.
+DELETE FROM participants
+USING (
+    SELECT
+        id,
+        ROW_NUMBER() OVER (
+            PARTITION BY trip_id, lower(email)
+            ORDER BY (rsvp_status <> 'pending') DESC, responded_at DESC NULLS LAST, id
+        ) AS "rank"
+    FROM participants
+) AS ranked
+WHERE
+    participants.id = ranked.id
+    AND ranked.rank > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS participants_trip_id_email_key
+    ON participants (trip_id, lower(email));
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_trip_id_email_key;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
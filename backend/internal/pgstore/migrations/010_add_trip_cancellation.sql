@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "cancelled_at"            TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "cancellation_reason"     TEXT;
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "cancellation_reason",
+    DROP COLUMN IF EXISTS "cancelled_at";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
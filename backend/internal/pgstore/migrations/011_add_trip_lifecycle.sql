@@ -0,0 +1,75 @@
+-- Write your migrate up statements here
+
+CREATE TYPE trip_status AS ENUM (
+    'draft',
+    'pending_confirmation',
+    'confirmed',
+    'in_progress',
+    'completed',
+    'cancelled',
+    'archived'
+);
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "status" trip_status NOT NULL DEFAULT 'draft';
+
+-- Every existing trip already had its confirmation e-mail queued.
+UPDATE trips
+SET
+    "status" = CASE
+        WHEN cancelled_at IS NOT NULL THEN 'cancelled'::trip_status
+        WHEN is_confirmed THEN 'confirmed'::trip_status
+        ELSE 'pending_confirmation'::trip_status
+    END;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "is_confirmed";
+
+CREATE TABLE IF NOT EXISTS trip_status_transitions (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "from_status"       trip_status,
+    "to_status"         trip_status                 NOT NULL,
+    "transitioned_at"   TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS trip_status_transitions_trip_id_idx
+    ON trip_status_transitions (trip_id, transitioned_at);
+
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, NULL, status
+FROM trips;
+
+-- Lets the lifecycle job find trips to start and complete without a scan.
+CREATE INDEX IF NOT EXISTS trips_status_idx
+    ON trips (status)
+    WHERE status IN ('confirmed', 'in_progress');
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS trips_status_idx;
+
+DROP TABLE IF EXISTS trip_status_transitions;
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "is_confirmed" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE trips
+SET
+    "is_confirmed" = true
+WHERE
+    status IN ('confirmed', 'in_progress', 'completed', 'cancelled', 'archived');
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "status";
+
+DROP TYPE IF EXISTS trip_status;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
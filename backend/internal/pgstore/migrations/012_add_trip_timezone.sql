@@ -0,0 +1,25 @@
+-- Write your migrate up statements here
+
+-- Times used to be stored without an offset, as the wall clock of whatever
+-- offset they were sent with. There is no telling which one that was, so they
+-- are read as UTC.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "timezone"    TEXT    NOT NULL    DEFAULT 'UTC',
+    ALTER COLUMN "starts_at" TYPE TIMESTAMPTZ USING "starts_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "ends_at" TYPE TIMESTAMPTZ USING "ends_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at" TYPE TIMESTAMPTZ USING "occurs_at" AT TIME ZONE 'UTC';
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at" TYPE TIMESTAMP USING "occurs_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE trips
+    ALTER COLUMN "ends_at" TYPE TIMESTAMP USING "ends_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "starts_at" TYPE TIMESTAMP USING "starts_at" AT TIME ZONE 'UTC',
+    DROP COLUMN IF EXISTS "timezone";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
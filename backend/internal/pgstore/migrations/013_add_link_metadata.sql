@@ -0,0 +1,38 @@
+-- Write your migrate up statements here
+
+CREATE TYPE link_category AS ENUM (
+    'booking',
+    'document',
+    'map',
+    'ticket'
+);
+
+-- Links that predate this migration have no known author and take their
+-- creation time from the migration.
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "description"  TEXT,
+    ADD COLUMN IF NOT EXISTS "category"     link_category,
+    ADD COLUMN IF NOT EXISTS "sort_order"   INTEGER         NOT NULL    DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "added_by"     TEXT,
+    ADD COLUMN IF NOT EXISTS "created_at"   TIMESTAMPTZ     NOT NULL    DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS "updated_at"   TIMESTAMPTZ     NOT NULL    DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS links_trip_id_sort_order_idx
+    ON links (trip_id, sort_order, created_at);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS links_trip_id_sort_order_idx;
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "updated_at",
+    DROP COLUMN IF EXISTS "created_at",
+    DROP COLUMN IF EXISTS "added_by",
+    DROP COLUMN IF EXISTS "sort_order",
+    DROP COLUMN IF EXISTS "category",
+    DROP COLUMN IF EXISTS "description";
+
+DROP TYPE IF EXISTS link_category;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
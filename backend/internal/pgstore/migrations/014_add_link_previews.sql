@@ -0,0 +1,40 @@
+-- Write your migrate up statements here
+
+CREATE TYPE link_preview_status AS ENUM (
+    'pending',
+    'ready',
+    'failed'
+);
+
+-- Existing links start out pending, so their previews are fetched too.
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "preview_status"           link_preview_status NOT NULL    DEFAULT 'pending',
+    ADD COLUMN IF NOT EXISTS "preview_title"            TEXT,
+    ADD COLUMN IF NOT EXISTS "preview_description"      TEXT,
+    ADD COLUMN IF NOT EXISTS "preview_image_url"        TEXT,
+    ADD COLUMN IF NOT EXISTS "preview_site_name"        TEXT,
+    ADD COLUMN IF NOT EXISTS "preview_attempts"         INTEGER             NOT NULL    DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "preview_next_attempt_at"  TIMESTAMPTZ         NOT NULL    DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS "preview_fetched_at"       TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS links_preview_pending_idx
+    ON links (preview_next_attempt_at) WHERE preview_status = 'pending';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS links_preview_pending_idx;
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "preview_fetched_at",
+    DROP COLUMN IF EXISTS "preview_next_attempt_at",
+    DROP COLUMN IF EXISTS "preview_attempts",
+    DROP COLUMN IF EXISTS "preview_site_name",
+    DROP COLUMN IF EXISTS "preview_image_url",
+    DROP COLUMN IF EXISTS "preview_description",
+    DROP COLUMN IF EXISTS "preview_title",
+    DROP COLUMN IF EXISTS "preview_status";
+
+DROP TYPE IF EXISTS link_preview_status;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
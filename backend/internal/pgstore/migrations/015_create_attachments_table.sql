@@ -0,0 +1,33 @@
+-- Write your migrate up statements here
+
+-- The files themselves live in the object storage under storage_key. A file
+-- attached to an activity stays attached to the trip when the activity is
+-- deleted.
+CREATE TABLE IF NOT EXISTS attachments (
+    "id"            uuid            PRIMARY KEY NOT NULL,
+    "trip_id"       uuid                        NOT NULL,
+    "activity_id"   uuid,
+    "file_name"     VARCHAR(255)                NOT NULL,
+    "content_type"  VARCHAR(255)                NOT NULL,
+    "size_bytes"    BIGINT                      NOT NULL,
+    "storage_key"   TEXT                        NOT NULL    UNIQUE,
+    "uploaded_by"   TEXT                        NOT NULL,
+    "created_at"    TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS attachments_trip_id_created_at_idx
+    ON attachments (trip_id, created_at);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS attachments;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
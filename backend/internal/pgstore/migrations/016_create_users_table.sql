@@ -0,0 +1,56 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS users (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "email"         TEXT                        NOT NULL,
+    "created_at"    TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+    "last_login_at" TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key
+    ON users (lower(email));
+
+-- Magic links are addressed to an e-mail, which only becomes a user once the
+-- link is followed.
+CREATE TABLE IF NOT EXISTS login_tokens (
+    "id"            uuid            PRIMARY KEY NOT NULL,
+    "token_hash"    BYTEA                       NOT NULL    UNIQUE,
+    "email"         TEXT                        NOT NULL,
+    "expires_at"    TIMESTAMPTZ                 NOT NULL,
+    "used_at"       TIMESTAMPTZ
+);
+
+-- Trips and participations are linked to the user holding their e-mail when
+-- that user signs in.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "owner_id" uuid REFERENCES users(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "user_id" uuid REFERENCES users(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS trips_owner_id_idx
+    ON trips (owner_id);
+
+CREATE INDEX IF NOT EXISTS participants_user_id_idx
+    ON participants (user_id);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_user_id_idx;
+DROP INDEX IF EXISTS trips_owner_id_idx;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "user_id";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "owner_id";
+
+DROP TABLE IF EXISTS login_tokens;
+DROP TABLE IF EXISTS users;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+
+-- The trip owner is not a participant, their role follows from
+-- trips.owner_email.
+CREATE TYPE participant_role AS ENUM (
+    'co_organiser',
+    'member',
+    'viewer'
+);
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "role" participant_role NOT NULL DEFAULT 'member';
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "role";
+
+DROP TYPE IF EXISTS participant_role;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,28 @@
+-- Write your migrate up statements here
+
+-- Accounts at OpenID Connect providers, by the subject the provider knows the
+-- user as. A user can sign in through several of them.
+CREATE TABLE IF NOT EXISTS user_identities (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "user_id"       uuid                        NOT NULL,
+    "issuer"        TEXT                        NOT NULL,
+    "subject"       TEXT                        NOT NULL,
+    "created_at"    TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+
+    UNIQUE (issuer, subject),
+
+    FOREIGN KEY (user_id) REFERENCES users(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS user_identities_user_id_idx
+    ON user_identities (user_id);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS user_identities_user_id_idx;
+DROP TABLE IF EXISTS user_identities;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,34 @@
+-- Write your migrate up statements here
+
+-- Hand-overs of a trip from its owner to one of its participants. A transfer
+-- is pending until the participant accepts it or the owner takes it back, and
+-- a trip has at most one pending transfer.
+CREATE TABLE IF NOT EXISTS ownership_transfers (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "participant_id"    uuid                        NOT NULL,
+    "from_email"        TEXT                        NOT NULL,
+    "created_at"        TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+    "accepted_at"       TIMESTAMPTZ,
+    "cancelled_at"      TIMESTAMPTZ,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ownership_transfers_pending_idx
+    ON ownership_transfers (trip_id)
+    WHERE accepted_at IS NULL AND cancelled_at IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS ownership_transfers_pending_idx;
+DROP TABLE IF EXISTS ownership_transfers;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
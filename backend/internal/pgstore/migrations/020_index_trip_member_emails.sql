@@ -0,0 +1,17 @@
+-- Write your migrate up statements here
+
+-- Trips are looked up by the e-mail of their owner and participants when
+-- listing the trips of a user, e-mails are compared case-insensitively.
+CREATE INDEX IF NOT EXISTS trips_owner_email_idx
+    ON trips (lower(owner_email));
+
+CREATE INDEX IF NOT EXISTS participants_email_idx
+    ON participants (lower(email));
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_email_idx;
+DROP INDEX IF EXISTS trips_owner_email_idx;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
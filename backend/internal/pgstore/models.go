@@ -5,37 +5,361 @@
 package pgstore
 
 import (
+	"database/sql/driver"
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type LinkCategory string
+
+const (
+	LinkCategoryBooking  LinkCategory = "booking"
+	LinkCategoryDocument LinkCategory = "document"
+	LinkCategoryMap      LinkCategory = "map"
+	LinkCategoryTicket   LinkCategory = "ticket"
+)
+
+func (e *LinkCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LinkCategory(s)
+	case string:
+		*e = LinkCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LinkCategory: %T", src)
+	}
+	return nil
+}
+
+type NullLinkCategory struct {
+	LinkCategory LinkCategory `json:"link_category"`
+	Valid        bool         `json:"valid"` // Valid is true if LinkCategory is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLinkCategory) Scan(value interface{}) error {
+	if value == nil {
+		ns.LinkCategory, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LinkCategory.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLinkCategory) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LinkCategory), nil
+}
+
+type LinkPreviewStatus string
+
+const (
+	LinkPreviewStatusPending LinkPreviewStatus = "pending"
+	LinkPreviewStatusReady   LinkPreviewStatus = "ready"
+	LinkPreviewStatusFailed  LinkPreviewStatus = "failed"
+)
+
+func (e *LinkPreviewStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LinkPreviewStatus(s)
+	case string:
+		*e = LinkPreviewStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LinkPreviewStatus: %T", src)
+	}
+	return nil
+}
+
+type NullLinkPreviewStatus struct {
+	LinkPreviewStatus LinkPreviewStatus `json:"link_preview_status"`
+	Valid             bool              `json:"valid"` // Valid is true if LinkPreviewStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLinkPreviewStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.LinkPreviewStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LinkPreviewStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLinkPreviewStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LinkPreviewStatus), nil
+}
+
+type ParticipantRole string
+
+const (
+	ParticipantRoleCoOrganiser ParticipantRole = "co_organiser"
+	ParticipantRoleMember      ParticipantRole = "member"
+	ParticipantRoleViewer      ParticipantRole = "viewer"
+)
+
+func (e *ParticipantRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ParticipantRole(s)
+	case string:
+		*e = ParticipantRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ParticipantRole: %T", src)
+	}
+	return nil
+}
+
+type NullParticipantRole struct {
+	ParticipantRole ParticipantRole `json:"participant_role"`
+	Valid           bool            `json:"valid"` // Valid is true if ParticipantRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullParticipantRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.ParticipantRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ParticipantRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullParticipantRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ParticipantRole), nil
+}
+
+type RsvpStatus string
+
+const (
+	RsvpStatusPending  RsvpStatus = "pending"
+	RsvpStatusAccepted RsvpStatus = "accepted"
+	RsvpStatusDeclined RsvpStatus = "declined"
+	RsvpStatusMaybe    RsvpStatus = "maybe"
+)
+
+func (e *RsvpStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RsvpStatus(s)
+	case string:
+		*e = RsvpStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RsvpStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRsvpStatus struct {
+	RsvpStatus RsvpStatus `json:"rsvp_status"`
+	Valid      bool       `json:"valid"` // Valid is true if RsvpStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRsvpStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RsvpStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RsvpStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRsvpStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RsvpStatus), nil
+}
+
+type TripStatus string
+
+const (
+	TripStatusDraft               TripStatus = "draft"
+	TripStatusPendingConfirmation TripStatus = "pending_confirmation"
+	TripStatusConfirmed           TripStatus = "confirmed"
+	TripStatusInProgress          TripStatus = "in_progress"
+	TripStatusCompleted           TripStatus = "completed"
+	TripStatusCancelled           TripStatus = "cancelled"
+	TripStatusArchived            TripStatus = "archived"
+)
+
+func (e *TripStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TripStatus(s)
+	case string:
+		*e = TripStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TripStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTripStatus struct {
+	TripStatus TripStatus `json:"trip_status"`
+	Valid      bool       `json:"valid"` // Valid is true if TripStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTripStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TripStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TripStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTripStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TripStatus), nil
+}
+
 type Activity struct {
-	ID       uuid.UUID        `db:"id" json:"id"`
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID          `db:"id" json:"id"`
+	TripID   uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title    string             `db:"title" json:"title"`
+	OccursAt pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+}
+
+type Attachment struct {
+	ID          uuid.UUID          `db:"id" json:"id"`
+	TripID      uuid.UUID          `db:"trip_id" json:"trip_id"`
+	ActivityID  pgtype.UUID        `db:"activity_id" json:"activity_id"`
+	FileName    string             `db:"file_name" json:"file_name"`
+	ContentType string             `db:"content_type" json:"content_type"`
+	SizeBytes   int64              `db:"size_bytes" json:"size_bytes"`
+	StorageKey  string             `db:"storage_key" json:"storage_key"`
+	UploadedBy  string             `db:"uploaded_by" json:"uploaded_by"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type ConfirmationToken struct {
+	ID            uuid.UUID          `db:"id" json:"id"`
+	TokenHash     []byte             `db:"token_hash" json:"token_hash"`
+	Purpose       string             `db:"purpose" json:"purpose"`
+	TripID        uuid.UUID          `db:"trip_id" json:"trip_id"`
+	ParticipantID pgtype.UUID        `db:"participant_id" json:"participant_id"`
+	ExpiresAt     pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	UsedAt        pgtype.Timestamptz `db:"used_at" json:"used_at"`
 }
 
 type Link struct {
-	ID     uuid.UUID `db:"id" json:"id"`
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+	ID                   uuid.UUID          `db:"id" json:"id"`
+	TripID               uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title                string             `db:"title" json:"title"`
+	Url                  string             `db:"url" json:"url"`
+	Description          pgtype.Text        `db:"description" json:"description"`
+	Category             NullLinkCategory   `db:"category" json:"category"`
+	SortOrder            int32              `db:"sort_order" json:"sort_order"`
+	AddedBy              pgtype.Text        `db:"added_by" json:"added_by"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	PreviewStatus        LinkPreviewStatus  `db:"preview_status" json:"preview_status"`
+	PreviewTitle         pgtype.Text        `db:"preview_title" json:"preview_title"`
+	PreviewDescription   pgtype.Text        `db:"preview_description" json:"preview_description"`
+	PreviewImageUrl      pgtype.Text        `db:"preview_image_url" json:"preview_image_url"`
+	PreviewSiteName      pgtype.Text        `db:"preview_site_name" json:"preview_site_name"`
+	PreviewAttempts      int32              `db:"preview_attempts" json:"preview_attempts"`
+	PreviewNextAttemptAt pgtype.Timestamptz `db:"preview_next_attempt_at" json:"preview_next_attempt_at"`
+	PreviewFetchedAt     pgtype.Timestamptz `db:"preview_fetched_at" json:"preview_fetched_at"`
+}
+
+type LoginToken struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	TokenHash []byte             `db:"token_hash" json:"token_hash"`
+	Email     string             `db:"email" json:"email"`
+	ExpiresAt pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `db:"used_at" json:"used_at"`
+}
+
+type Outbox struct {
+	ID            uuid.UUID          `db:"id" json:"id"`
+	Kind          string             `db:"kind" json:"kind"`
+	Payload       []byte             `db:"payload" json:"payload"`
+	Status        string             `db:"status" json:"status"`
+	Attempts      int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastError     pgtype.Text        `db:"last_error" json:"last_error"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	SentAt        pgtype.Timestamptz `db:"sent_at" json:"sent_at"`
+}
+
+type OwnershipTransfer struct {
+	ID            uuid.UUID          `db:"id" json:"id"`
+	TripID        uuid.UUID          `db:"trip_id" json:"trip_id"`
+	ParticipantID uuid.UUID          `db:"participant_id" json:"participant_id"`
+	FromEmail     string             `db:"from_email" json:"from_email"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	AcceptedAt    pgtype.Timestamptz `db:"accepted_at" json:"accepted_at"`
+	CancelledAt   pgtype.Timestamptz `db:"cancelled_at" json:"cancelled_at"`
 }
 
 type Participant struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	TripID      uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email       string    `db:"email" json:"email"`
-	IsConfirmed bool      `db:"is_confirmed" json:"is_confirmed"`
+	ID          uuid.UUID          `db:"id" json:"id"`
+	TripID      uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Email       string             `db:"email" json:"email"`
+	Name        pgtype.Text        `db:"name" json:"name"`
+	Phone       pgtype.Text        `db:"phone" json:"phone"`
+	Notes       pgtype.Text        `db:"notes" json:"notes"`
+	RsvpStatus  RsvpStatus         `db:"rsvp_status" json:"rsvp_status"`
+	RespondedAt pgtype.Timestamptz `db:"responded_at" json:"responded_at"`
+	RsvpComment pgtype.Text        `db:"rsvp_comment" json:"rsvp_comment"`
+	UserID      pgtype.UUID        `db:"user_id" json:"user_id"`
+	Role        ParticipantRole    `db:"role" json:"role"`
 }
 
 type Trip struct {
-	ID          uuid.UUID        `db:"id" json:"id"`
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	ID                 uuid.UUID          `db:"id" json:"id"`
+	Destination        string             `db:"destination" json:"destination"`
+	OwnerEmail         string             `db:"owner_email" json:"owner_email"`
+	OwnerName          string             `db:"owner_name" json:"owner_name"`
+	StartsAt           pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	EndsAt             pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	CancelledAt        pgtype.Timestamptz `db:"cancelled_at" json:"cancelled_at"`
+	CancellationReason pgtype.Text        `db:"cancellation_reason" json:"cancellation_reason"`
+	Status             TripStatus         `db:"status" json:"status"`
+	Timezone           string             `db:"timezone" json:"timezone"`
+	OwnerID            pgtype.UUID        `db:"owner_id" json:"owner_id"`
+}
+
+type TripStatusTransition struct {
+	ID             uuid.UUID          `db:"id" json:"id"`
+	TripID         uuid.UUID          `db:"trip_id" json:"trip_id"`
+	FromStatus     NullTripStatus     `db:"from_status" json:"from_status"`
+	ToStatus       TripStatus         `db:"to_status" json:"to_status"`
+	TransitionedAt pgtype.Timestamptz `db:"transitioned_at" json:"transitioned_at"`
+}
+
+type UserIdentity struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	UserID    uuid.UUID          `db:"user_id" json:"user_id"`
+	Issuer    string             `db:"issuer" json:"issuer"`
+	Subject   string             `db:"subject" json:"subject"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type User struct {
+	ID          uuid.UUID          `db:"id" json:"id"`
+	Email       string             `db:"email" json:"email"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	LastLoginAt pgtype.Timestamptz `db:"last_login_at" json:"last_login_at"`
 }
@@ -0,0 +1,65 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type OutboxKind string
+
+const (
+	OutboxConfirmTripOwner    OutboxKind = "confirm_trip_owner"
+	OutboxReconfirmTripOwner  OutboxKind = "reconfirm_trip_owner"
+	OutboxTripInvitation      OutboxKind = "trip_invitation"
+	OutboxParticipantRemoved  OutboxKind = "participant_removed"
+	OutboxInvitationRevoked   OutboxKind = "invitation_revoked"
+	OutboxTripCancelled       OutboxKind = "trip_cancelled"
+	OutboxMagicLink           OutboxKind = "magic_link"
+	OutboxOwnershipTransfer   OutboxKind = "ownership_transfer"
+	OutboxOwnershipReceived   OutboxKind = "ownership_received"
+	OutboxOwnershipHandedOver OutboxKind = "ownership_handed_over"
+)
+
+// OutboxPayload is stored as JSON next to each outbox message. Fields that do
+// not apply to a kind are left empty.
+type OutboxPayload struct {
+	TripID        uuid.UUID `json:"trip_id"`
+	ParticipantID uuid.UUID `json:"participant_id"`
+	// Email and Name address participants that no longer exist by the time
+	// the message is delivered.
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// enqueue must be called on a Queries bound to a transaction so the message is
+// only published when the domain change it belongs to is committed.
+func (q *Queries) enqueue(ctx context.Context, kind OutboxKind, payload OutboxPayload) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to marshal %s outbox payload: %w", kind, err)
+	}
+
+	return q.EnqueueOutboxMessage(ctx, EnqueueOutboxMessageParams{
+		Kind:    string(kind),
+		Payload: raw,
+	})
+}
+
+// enqueueBatch is enqueue for many messages of the same kind, written with a
+// single COPY.
+func (q *Queries) enqueueBatch(ctx context.Context, kind OutboxKind, payloads []OutboxPayload) error {
+	messages := make([]EnqueueOutboxMessagesParams, len(payloads))
+	for i, payload := range payloads {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to marshal %s outbox payload: %w", kind, err)
+		}
+		messages[i] = EnqueueOutboxMessagesParams{Kind: string(kind), Payload: raw}
+	}
+
+	_, err := q.EnqueueOutboxMessages(ctx, messages)
+	return err
+}
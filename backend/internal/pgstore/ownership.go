@@ -0,0 +1,225 @@
+package pgstore
+
+import (
+	"SwallowGo/internal/tokens"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RequestOwnershipTransfer nominates a participant to take the trip over from
+// its owner and queues the e-mail they accept it with. A transfer that was
+// still pending is replaced. It returns pgx.ErrNoRows when the participant is
+// not on the trip, ErrParticipantNotConfirmed when they have not accepted
+// their invitation and ErrTripClosed when the trip can no longer be changed.
+func (q *Queries) RequestOwnershipTransfer(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RequestOwnershipTransfer: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	trip, err := qtx.GetTripForUpdate(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for RequestOwnershipTransfer: %w", err)
+	}
+
+	if !trip.Status.Open() {
+		return ErrTripClosed
+	}
+
+	participant, err := qtx.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participant for RequestOwnershipTransfer: %w", err)
+	}
+
+	if participant.TripID != tripID {
+		return fmt.Errorf("pgstore: participant is not on the trip for RequestOwnershipTransfer: %w", pgx.ErrNoRows)
+	}
+
+	if participant.RsvpStatus != RsvpStatusAccepted {
+		return ErrParticipantNotConfirmed
+	}
+
+	if _, err := qtx.CancelOwnershipTransfers(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel pending transfer for RequestOwnershipTransfer: %w", err)
+	}
+
+	if err := qtx.CreateOwnershipTransfer(ctx, CreateOwnershipTransferParams{
+		TripID:        tripID,
+		ParticipantID: participantID,
+		FromEmail:     trip.OwnerEmail,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to create transfer for RequestOwnershipTransfer: %w", err)
+	}
+
+	if err := qtx.enqueue(ctx, OutboxOwnershipTransfer, OutboxPayload{TripID: tripID, ParticipantID: participantID}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue email for RequestOwnershipTransfer: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for RequestOwnershipTransfer: %w", err)
+	}
+
+	return nil
+}
+
+// WithdrawOwnershipTransfer takes back the pending transfer of a trip, the
+// link already sent to the participant stops working. It returns pgx.ErrNoRows
+// when no transfer is pending.
+func (q *Queries) WithdrawOwnershipTransfer(ctx context.Context, tripID uuid.UUID) error {
+	n, err := q.CancelOwnershipTransfers(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to cancel transfer for WithdrawOwnershipTransfer: %w", err)
+	}
+	if n == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// AcceptOwnershipWithToken consumes the token e-mailed to a nominated
+// participant and swaps them with the owner: the participant becomes the
+// owner of the trip and the previous owner takes their place on the
+// participant list as a co-organiser. Both are told about it once the swap is
+// committed.
+//
+// It returns ErrInvalidConfirmationToken when the token can't be used,
+// ErrNoOwnershipTransfer when the transfer was withdrawn or the trip changed
+// hands in the meantime, ErrParticipantNotConfirmed when the participant
+// withdrew from the trip since and ErrTripClosed when the trip can no longer
+// be changed.
+func (q *Queries) AcceptOwnershipWithToken(ctx context.Context, pool *pgxpool.Pool, participantID uuid.UUID, tokenHash []byte) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for AcceptOwnershipWithToken: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	token, err := qtx.ConsumeConfirmationToken(ctx, ConsumeConfirmationTokenParams{
+		TokenHash: tokenHash,
+		Purpose:   string(tokens.PurposeOwnershipTransfer),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidConfirmationToken
+		}
+		return fmt.Errorf("pgstore: failed to consume token for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if !token.ParticipantID.Valid || uuid.UUID(token.ParticipantID.Bytes) != participantID {
+		return ErrInvalidConfirmationToken
+	}
+
+	// Locking the trip keeps a concurrent transfer or edit of the owner from
+	// interleaving with the swap.
+	trip, err := qtx.GetTripForUpdate(ctx, token.TripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if !trip.Status.Open() {
+		return ErrTripClosed
+	}
+
+	fromEmail, err := qtx.AcceptOwnershipTransfer(ctx, AcceptOwnershipTransferParams{TripID: trip.ID, ParticipantID: participantID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoOwnershipTransfer
+		}
+		return fmt.Errorf("pgstore: failed to accept transfer for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if !strings.EqualFold(fromEmail, trip.OwnerEmail) {
+		return ErrNoOwnershipTransfer
+	}
+
+	participant, err := qtx.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participant for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if participant.RsvpStatus != RsvpStatusAccepted {
+		return ErrParticipantNotConfirmed
+	}
+
+	// The owner may have been invited to their own trip. That invitation
+	// makes way for the row they take over, e-mails are unique on a trip.
+	ownerRow, err := qtx.GetTripParticipantIDByEmail(ctx, GetTripParticipantIDByEmailParams{TripID: trip.ID, Email: trip.OwnerEmail})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("pgstore: failed to look up owner for AcceptOwnershipWithToken: %w", err)
+	}
+	if err == nil && ownerRow != participantID {
+		if _, err := qtx.DeleteParticipant(ctx, DeleteParticipantParams{ID: ownerRow, TripID: trip.ID}); err != nil {
+			return fmt.Errorf("pgstore: failed to delete owner invitation for AcceptOwnershipWithToken: %w", err)
+		}
+		if err := qtx.CancelParticipantOutboxMessages(ctx, ownerRow.String()); err != nil {
+			return fmt.Errorf("pgstore: failed to cancel emails for AcceptOwnershipWithToken: %w", err)
+		}
+	}
+
+	newOwnerName := participant.Email
+	if participant.Name.Valid && participant.Name.String != "" {
+		newOwnerName = participant.Name.String
+	}
+
+	if err := qtx.TransferTripOwner(ctx, TransferTripOwnerParams{
+		ID:         trip.ID,
+		OwnerEmail: participant.Email,
+		OwnerName:  newOwnerName,
+		OwnerID:    participant.UserID,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to transfer trip for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if err := qtx.HandOverParticipant(ctx, HandOverParticipantParams{
+		ID:     participantID,
+		Email:  trip.OwnerEmail,
+		Name:   pgtype.Text{String: trip.OwnerName, Valid: true},
+		UserID: trip.OwnerID,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to hand over participant for AcceptOwnershipWithToken: %w", err)
+	}
+
+	// Whatever was sent to the participant row was meant for the new owner,
+	// it must not work for the previous one.
+	if err := qtx.RevokeParticipantTokens(ctx, pgtype.UUID{Bytes: participantID, Valid: true}); err != nil {
+		return fmt.Errorf("pgstore: failed to revoke tokens for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if err := qtx.CancelParticipantOutboxMessages(ctx, participantID.String()); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel emails for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if err := qtx.enqueue(ctx, OutboxOwnershipReceived, OutboxPayload{
+		TripID: trip.ID,
+		Email:  participant.Email,
+		Name:   newOwnerName,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue email for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if err := qtx.enqueue(ctx, OutboxOwnershipHandedOver, OutboxPayload{
+		TripID: trip.ID,
+		Email:  trip.OwnerEmail,
+		Name:   trip.OwnerName,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue email for AcceptOwnershipWithToken: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for AcceptOwnershipWithToken: %w", err)
+	}
+
+	return nil
+}
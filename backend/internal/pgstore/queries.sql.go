@@ -12,114 +12,1465 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const confirmParticipant = `-- name: ConfirmParticipant :exec
-UPDATE participants
-SET 
-    "is_confirmed" = true
+const acceptOwnershipTransfer = `-- name: AcceptOwnershipTransfer :one
+UPDATE ownership_transfers
+SET
+    "accepted_at" = now()
+WHERE
+    trip_id = $1
+    AND participant_id = $2
+    AND accepted_at IS NULL
+    AND cancelled_at IS NULL
+RETURNING "from_email"
+`
+
+type AcceptOwnershipTransferParams struct {
+	TripID        uuid.UUID `db:"trip_id" json:"trip_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) AcceptOwnershipTransfer(ctx context.Context, arg AcceptOwnershipTransferParams) (string, error) {
+	row := q.db.QueryRow(ctx, acceptOwnershipTransfer, arg.TripID, arg.ParticipantID)
+	var from_email string
+	err := row.Scan(&from_email)
+	return from_email, err
+}
+
+const cancelOwnershipTransfers = `-- name: CancelOwnershipTransfers :execrows
+UPDATE ownership_transfers
+SET
+    "cancelled_at" = now()
+WHERE
+    trip_id = $1
+    AND accepted_at IS NULL
+    AND cancelled_at IS NULL
+`
+
+func (q *Queries) CancelOwnershipTransfers(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelOwnershipTransfers, tripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelParticipantOutboxMessages = `-- name: CancelParticipantOutboxMessages :exec
+DELETE FROM outbox
+WHERE
+    status = 'pending'
+    AND payload->>'participant_id' = $1::text
+`
+
+func (q *Queries) CancelParticipantOutboxMessages(ctx context.Context, participantID string) error {
+	_, err := q.db.Exec(ctx, cancelParticipantOutboxMessages, participantID)
+	return err
+}
+
+const cancelTripOutboxMessages = `-- name: CancelTripOutboxMessages :exec
+DELETE FROM outbox
+WHERE
+    status = 'pending'
+    AND payload->>'trip_id' = $1::text
+`
+
+func (q *Queries) CancelTripOutboxMessages(ctx context.Context, tripID string) error {
+	_, err := q.db.Exec(ctx, cancelTripOutboxMessages, tripID)
+	return err
+}
+
+const claimLinkPreviews = `-- name: ClaimLinkPreviews :many
+UPDATE links
+SET
+    "preview_attempts" = preview_attempts + 1,
+    "preview_next_attempt_at" = now() + make_interval(secs => $1::float8)
+WHERE
+    id IN (
+        SELECT id
+        FROM links
+        WHERE
+            preview_status = 'pending'
+            AND preview_next_attempt_at <= now()
+        ORDER BY preview_next_attempt_at
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "url", "preview_attempts"
+`
+
+type ClaimLinkPreviewsParams struct {
+	LeaseSeconds float64 `db:"lease_seconds" json:"lease_seconds"`
+	BatchSize    int32   `db:"batch_size" json:"batch_size"`
+}
+
+type ClaimLinkPreviewsRow struct {
+	ID              uuid.UUID `db:"id" json:"id"`
+	Url             string    `db:"url" json:"url"`
+	PreviewAttempts int32     `db:"preview_attempts" json:"preview_attempts"`
+}
+
+func (q *Queries) ClaimLinkPreviews(ctx context.Context, arg ClaimLinkPreviewsParams) ([]ClaimLinkPreviewsRow, error) {
+	rows, err := q.db.Query(ctx, claimLinkPreviews, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimLinkPreviewsRow
+	for rows.Next() {
+		var i ClaimLinkPreviewsRow
+		if err := rows.Scan(&i.ID, &i.Url, &i.PreviewAttempts); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimOutboxMessages = `-- name: ClaimOutboxMessages :many
+UPDATE outbox
+SET
+    "attempts" = attempts + 1,
+    "next_attempt_at" = now() + make_interval(secs => $1::float8)
+WHERE
+    id IN (
+        SELECT id
+        FROM outbox
+        WHERE
+            status = 'pending'
+            AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "kind", "payload", "attempts"
+`
+
+type ClaimOutboxMessagesParams struct {
+	LeaseSeconds float64 `db:"lease_seconds" json:"lease_seconds"`
+	BatchSize    int32   `db:"batch_size" json:"batch_size"`
+}
+
+type ClaimOutboxMessagesRow struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	Kind     string    `db:"kind" json:"kind"`
+	Payload  []byte    `db:"payload" json:"payload"`
+	Attempts int32     `db:"attempts" json:"attempts"`
+}
+
+func (q *Queries) ClaimOutboxMessages(ctx context.Context, arg ClaimOutboxMessagesParams) ([]ClaimOutboxMessagesRow, error) {
+	rows, err := q.db.Query(ctx, claimOutboxMessages, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimOutboxMessagesRow
+	for rows.Next() {
+		var i ClaimOutboxMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeDueTrips = `-- name: CompleteDueTrips :many
+WITH completed AS (
+    UPDATE trips
+    SET
+        "status" = 'completed'
+    WHERE
+        status = 'in_progress'
+        AND ends_at <= now()
+    RETURNING "id"
+)
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, 'in_progress', 'completed'
+FROM completed
+RETURNING "trip_id"
+`
+
+func (q *Queries) CompleteDueTrips(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, completeDueTrips)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var trip_id uuid.UUID
+		if err := rows.Scan(&trip_id); err != nil {
+			return nil, err
+		}
+		items = append(items, trip_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const consumeConfirmationToken = `-- name: ConsumeConfirmationToken :one
+UPDATE confirmation_tokens
+SET
+    "used_at" = now()
+WHERE
+    token_hash = $1
+    AND purpose = $2
+    AND used_at IS NULL
+    AND expires_at > now()
+RETURNING "trip_id", "participant_id"
+`
+
+type ConsumeConfirmationTokenParams struct {
+	TokenHash []byte `db:"token_hash" json:"token_hash"`
+	Purpose   string `db:"purpose" json:"purpose"`
+}
+
+type ConsumeConfirmationTokenRow struct {
+	TripID        uuid.UUID   `db:"trip_id" json:"trip_id"`
+	ParticipantID pgtype.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) ConsumeConfirmationToken(ctx context.Context, arg ConsumeConfirmationTokenParams) (ConsumeConfirmationTokenRow, error) {
+	row := q.db.QueryRow(ctx, consumeConfirmationToken, arg.TokenHash, arg.Purpose)
+	var i ConsumeConfirmationTokenRow
+	err := row.Scan(&i.TripID, &i.ParticipantID)
+	return i, err
+}
+
+const consumeLoginToken = `-- name: ConsumeLoginToken :one
+UPDATE login_tokens
+SET
+    "used_at" = now()
+WHERE
+    id = $1
+    AND token_hash = $2
+    AND used_at IS NULL
+    AND expires_at > now()
+RETURNING "email"
+`
+
+type ConsumeLoginTokenParams struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	TokenHash []byte    `db:"token_hash" json:"token_hash"`
+}
+
+func (q *Queries) ConsumeLoginToken(ctx context.Context, arg ConsumeLoginTokenParams) (string, error) {
+	row := q.db.QueryRow(ctx, consumeLoginToken, arg.ID, arg.TokenHash)
+	var email string
+	err := row.Scan(&email)
+	return email, err
+}
+
+const countTripRsvps = `-- name: CountTripRsvps :one
+SELECT
+    COUNT(*) FILTER (WHERE rsvp_status = 'pending') AS "pending",
+    COUNT(*) FILTER (WHERE rsvp_status = 'accepted') AS "accepted",
+    COUNT(*) FILTER (WHERE rsvp_status = 'declined') AS "declined",
+    COUNT(*) FILTER (WHERE rsvp_status = 'maybe') AS "maybe"
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+type CountTripRsvpsRow struct {
+	Pending  int64 `db:"pending" json:"pending"`
+	Accepted int64 `db:"accepted" json:"accepted"`
+	Declined int64 `db:"declined" json:"declined"`
+	Maybe    int64 `db:"maybe" json:"maybe"`
+}
+
+func (q *Queries) CountTripRsvps(ctx context.Context, tripID uuid.UUID) (CountTripRsvpsRow, error) {
+	row := q.db.QueryRow(ctx, countTripRsvps, tripID)
+	var i CountTripRsvpsRow
+	err := row.Scan(
+		&i.Pending,
+		&i.Accepted,
+		&i.Declined,
+		&i.Maybe,
+	)
+	return i, err
+}
+
+const createActivity = `-- name: CreateActivity :one
+INSERT INTO activities
+    ( "trip_id", "title", "occurs_at" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreateActivityParams struct {
+	TripID   uuid.UUID          `db:"trip_id" json:"trip_id"`
+	Title    string             `db:"title" json:"title"`
+	OccursAt pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+}
+
+func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createAttachment = `-- name: CreateAttachment :exec
+INSERT INTO attachments
+    ( "id", "trip_id", "activity_id", "file_name", "content_type", "size_bytes", "storage_key", "uploaded_by" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
+`
+
+type CreateAttachmentParams struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	TripID      uuid.UUID   `db:"trip_id" json:"trip_id"`
+	ActivityID  pgtype.UUID `db:"activity_id" json:"activity_id"`
+	FileName    string      `db:"file_name" json:"file_name"`
+	ContentType string      `db:"content_type" json:"content_type"`
+	SizeBytes   int64       `db:"size_bytes" json:"size_bytes"`
+	StorageKey  string      `db:"storage_key" json:"storage_key"`
+	UploadedBy  string      `db:"uploaded_by" json:"uploaded_by"`
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) error {
+	_, err := q.db.Exec(ctx, createAttachment,
+		arg.ID,
+		arg.TripID,
+		arg.ActivityID,
+		arg.FileName,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.StorageKey,
+		arg.UploadedBy,
+	)
+	return err
+}
+
+const createConfirmationToken = `-- name: CreateConfirmationToken :exec
+INSERT INTO confirmation_tokens
+    ( "token_hash", "purpose", "trip_id", "participant_id", "expires_at" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+`
+
+type CreateConfirmationTokenParams struct {
+	TokenHash     []byte             `db:"token_hash" json:"token_hash"`
+	Purpose       string             `db:"purpose" json:"purpose"`
+	TripID        uuid.UUID          `db:"trip_id" json:"trip_id"`
+	ParticipantID pgtype.UUID        `db:"participant_id" json:"participant_id"`
+	ExpiresAt     pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) CreateConfirmationToken(ctx context.Context, arg CreateConfirmationTokenParams) error {
+	_, err := q.db.Exec(ctx, createConfirmationToken,
+		arg.TokenHash,
+		arg.Purpose,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createLoginToken = `-- name: CreateLoginToken :exec
+INSERT INTO login_tokens
+    ( "id", "token_hash", "email", "expires_at" ) VALUES
+    ( $1, $2, $3, $4 )
+`
+
+type CreateLoginTokenParams struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	TokenHash []byte             `db:"token_hash" json:"token_hash"`
+	Email     string             `db:"email" json:"email"`
+	ExpiresAt pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) CreateLoginToken(ctx context.Context, arg CreateLoginTokenParams) error {
+	_, err := q.db.Exec(ctx, createLoginToken,
+		arg.ID,
+		arg.TokenHash,
+		arg.Email,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createOwnershipTransfer = `-- name: CreateOwnershipTransfer :exec
+INSERT INTO ownership_transfers
+    ( "trip_id", "participant_id", "from_email" ) VALUES
+    ( $1, $2, $3 )
+`
+
+type CreateOwnershipTransferParams struct {
+	TripID        uuid.UUID `db:"trip_id" json:"trip_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	FromEmail     string    `db:"from_email" json:"from_email"`
+}
+
+func (q *Queries) CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) error {
+	_, err := q.db.Exec(ctx, createOwnershipTransfer, arg.TripID, arg.ParticipantID, arg.FromEmail)
+	return err
+}
+
+const createTripLink = `-- name: CreateTripLink :one
+INSERT INTO links
+    ( "trip_id", "title", "url", "description", "category", "sort_order", "added_by" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id"
+`
+
+type CreateTripLinkParams struct {
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title       string           `db:"title" json:"title"`
+	Url         string           `db:"url" json:"url"`
+	Description pgtype.Text      `db:"description" json:"description"`
+	Category    NullLinkCategory `db:"category" json:"category"`
+	SortOrder   int32            `db:"sort_order" json:"sort_order"`
+	AddedBy     pgtype.Text      `db:"added_by" json:"added_by"`
+}
+
+func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTripLink,
+		arg.TripID,
+		arg.Title,
+		arg.Url,
+		arg.Description,
+		arg.Category,
+		arg.SortOrder,
+		arg.AddedBy,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+INSERT INTO user_identities
+    ( "user_id", "issuer", "subject" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (issuer, subject) DO NOTHING
+`
+
+type CreateUserIdentityParams struct {
+	UserID  uuid.UUID `db:"user_id" json:"user_id"`
+	Issuer  string    `db:"issuer" json:"issuer"`
+	Subject string    `db:"subject" json:"subject"`
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, createUserIdentity, arg.UserID, arg.Issuer, arg.Subject)
+	return err
+}
+
+const deadLetterOutboxMessage = `-- name: DeadLetterOutboxMessage :exec
+UPDATE outbox
+SET
+    "status" = 'dead',
+    "last_error" = $1
+WHERE
+    id = $2
+`
+
+type DeadLetterOutboxMessageParams struct {
+	LastError pgtype.Text `db:"last_error" json:"last_error"`
+	ID        uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) DeadLetterOutboxMessage(ctx context.Context, arg DeadLetterOutboxMessageParams) error {
+	_, err := q.db.Exec(ctx, deadLetterOutboxMessage, arg.LastError, arg.ID)
+	return err
+}
+
+const deleteActivity = `-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteActivity(ctx context.Context, arg DeleteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDraftTrip = `-- name: DeleteDraftTrip :one
+DELETE FROM trips
+WHERE
+    id = $1
+    AND status IN ('draft', 'pending_confirmation')
+RETURNING "id"
+`
+
+func (q *Queries) DeleteDraftTrip(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteDraftTrip, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteParticipant = `-- name: DeleteParticipant :one
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+RETURNING "email", "name", "rsvp_status"
+`
+
+type DeleteParticipantParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+type DeleteParticipantRow struct {
+	Email      string      `db:"email" json:"email"`
+	Name       pgtype.Text `db:"name" json:"name"`
+	RsvpStatus RsvpStatus  `db:"rsvp_status" json:"rsvp_status"`
+}
+
+func (q *Queries) DeleteParticipant(ctx context.Context, arg DeleteParticipantParams) (DeleteParticipantRow, error) {
+	row := q.db.QueryRow(ctx, deleteParticipant, arg.ID, arg.TripID)
+	var i DeleteParticipantRow
+	err := row.Scan(&i.Email, &i.Name, &i.RsvpStatus)
+	return i, err
+}
+
+const deletePendingParticipant = `-- name: DeletePendingParticipant :one
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND rsvp_status = 'pending'
+RETURNING "email", "name"
+`
+
+type DeletePendingParticipantParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+type DeletePendingParticipantRow struct {
+	Email string      `db:"email" json:"email"`
+	Name  pgtype.Text `db:"name" json:"name"`
+}
+
+func (q *Queries) DeletePendingParticipant(ctx context.Context, arg DeletePendingParticipantParams) (DeletePendingParticipantRow, error) {
+	row := q.db.QueryRow(ctx, deletePendingParticipant, arg.ID, arg.TripID)
+	var i DeletePendingParticipantRow
+	err := row.Scan(&i.Email, &i.Name)
+	return i, err
+}
+
+const deleteTripAttachment = `-- name: DeleteTripAttachment :execrows
+DELETE FROM attachments
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteTripAttachmentParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteTripAttachment(ctx context.Context, arg DeleteTripAttachmentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripAttachment, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripLink = `-- name: DeleteTripLink :execrows
+DELETE FROM links
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteTripLinkParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteTripLink(ctx context.Context, arg DeleteTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripLink, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueOutboxMessage = `-- name: EnqueueOutboxMessage :exec
+INSERT INTO outbox
+    ( "kind", "payload" ) VALUES
+    ( $1, $2 )
+`
+
+type EnqueueOutboxMessageParams struct {
+	Kind    string `db:"kind" json:"kind"`
+	Payload []byte `db:"payload" json:"payload"`
+}
+
+func (q *Queries) EnqueueOutboxMessage(ctx context.Context, arg EnqueueOutboxMessageParams) error {
+	_, err := q.db.Exec(ctx, enqueueOutboxMessage, arg.Kind, arg.Payload)
+	return err
+}
+
+type EnqueueOutboxMessagesParams struct {
+	Kind    string `db:"kind" json:"kind"`
+	Payload []byte `db:"payload" json:"payload"`
+}
+
+const failLinkPreview = `-- name: FailLinkPreview :exec
+UPDATE links
+SET
+    "preview_status" = 'failed',
+    "preview_fetched_at" = now()
+WHERE
+    id = $1
+    AND url = $2
+`
+
+type FailLinkPreviewParams struct {
+	ID  uuid.UUID `db:"id" json:"id"`
+	Url string    `db:"url" json:"url"`
+}
+
+func (q *Queries) FailLinkPreview(ctx context.Context, arg FailLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, failLinkPreview, arg.ID, arg.Url)
+	return err
+}
+
+const getConfirmationToken = `-- name: GetConfirmationToken :one
+SELECT
+    "trip_id", "participant_id"
+FROM confirmation_tokens
+WHERE
+    token_hash = $1
+    AND purpose = $2
+    AND used_at IS NULL
+    AND expires_at > now()
+`
+
+type GetConfirmationTokenParams struct {
+	TokenHash []byte `db:"token_hash" json:"token_hash"`
+	Purpose   string `db:"purpose" json:"purpose"`
+}
+
+type GetConfirmationTokenRow struct {
+	TripID        uuid.UUID   `db:"trip_id" json:"trip_id"`
+	ParticipantID pgtype.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) GetConfirmationToken(ctx context.Context, arg GetConfirmationTokenParams) (GetConfirmationTokenRow, error) {
+	row := q.db.QueryRow(ctx, getConfirmationToken, arg.TokenHash, arg.Purpose)
+	var i GetConfirmationTokenRow
+	err := row.Scan(&i.TripID, &i.ParticipantID)
+	return i, err
+}
+
+const getIdentityUserID = `-- name: GetIdentityUserID :one
+SELECT
+    "user_id"
+FROM user_identities
+WHERE
+    issuer = $1
+    AND subject = $2
+`
+
+type GetIdentityUserIDParams struct {
+	Issuer  string `db:"issuer" json:"issuer"`
+	Subject string `db:"subject" json:"subject"`
+}
+
+func (q *Queries) GetIdentityUserID(ctx context.Context, arg GetIdentityUserIDParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getIdentityUserID, arg.Issuer, arg.Subject)
+	var user_id uuid.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const getLoginToken = `-- name: GetLoginToken :one
+SELECT
+    "email", "expires_at"
+FROM login_tokens
+WHERE
+    id = $1
+    AND token_hash = $2
+    AND used_at IS NULL
+    AND expires_at > now()
+`
+
+type GetLoginTokenParams struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	TokenHash []byte    `db:"token_hash" json:"token_hash"`
+}
+
+type GetLoginTokenRow struct {
+	Email     string             `db:"email" json:"email"`
+	ExpiresAt pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) GetLoginToken(ctx context.Context, arg GetLoginTokenParams) (GetLoginTokenRow, error) {
+	row := q.db.QueryRow(ctx, getLoginToken, arg.ID, arg.TokenHash)
+	var i GetLoginTokenRow
+	err := row.Scan(&i.Email, &i.ExpiresAt)
+	return i, err
+}
+
+const getOrphanedActivities = `-- name: GetOrphanedActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    trip_id = $1
+    AND occurs_at BETWEEN $2 AND $3
+    AND (occurs_at < $4 OR occurs_at > $5)
+ORDER BY occurs_at
+`
+
+type GetOrphanedActivitiesParams struct {
+	TripID      uuid.UUID          `db:"trip_id" json:"trip_id"`
+	OldStartsAt pgtype.Timestamptz `db:"old_starts_at" json:"old_starts_at"`
+	OldEndsAt   pgtype.Timestamptz `db:"old_ends_at" json:"old_ends_at"`
+	StartsAt    pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	EndsAt      pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) GetOrphanedActivities(ctx context.Context, arg GetOrphanedActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getOrphanedActivities,
+		arg.TripID,
+		arg.OldStartsAt,
+		arg.OldEndsAt,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT
+    "id", "trip_id", "email", "name", "phone", "notes", "rsvp_status", "responded_at", "rsvp_comment", "user_id", "role"
+FROM participants
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.Name,
+		&i.Phone,
+		&i.Notes,
+		&i.RsvpStatus,
+		&i.RespondedAt,
+		&i.RsvpComment,
+		&i.UserID,
+		&i.Role,
+	)
+	return i, err
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT
+    "id", "trip_id", "email", "name", "phone", "notes", "rsvp_status", "responded_at", "rsvp_comment", "user_id", "role"
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.Name,
+			&i.Phone,
+			&i.Notes,
+			&i.RsvpStatus,
+			&i.RespondedAt,
+			&i.RsvpComment,
+			&i.UserID,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingOwnershipTransfer = `-- name: GetPendingOwnershipTransfer :one
+SELECT
+    "id", "trip_id", "participant_id", "from_email", "created_at", "accepted_at", "cancelled_at"
+FROM ownership_transfers
+WHERE
+    trip_id = $1
+    AND accepted_at IS NULL
+    AND cancelled_at IS NULL
+`
+
+func (q *Queries) GetPendingOwnershipTransfer(ctx context.Context, tripID uuid.UUID) (OwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, getPendingOwnershipTransfer, tripID)
+	var i OwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.FromEmail,
+		&i.CreatedAt,
+		&i.AcceptedAt,
+		&i.CancelledAt,
+	)
+	return i, err
+}
+
+const getTrip = `-- name: GetTrip :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CancelledAt,
+		&i.CancellationReason,
+		&i.Status,
+		&i.Timezone,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
+const getTripActivities = `-- name: GetTripActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    trip_id = $1
+ORDER BY occurs_at
+`
+
+func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivity = `-- name: GetTripActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
 WHERE
     id = $1
+    AND trip_id = $2
 `
 
-func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmParticipant, id)
-	return err
+type GetTripActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
 }
 
-const confirmTrip = `-- name: ConfirmTrip :exec
-UPDATE trips
-SET 
-    "is_confirmed" = true
+func (q *Queries) GetTripActivity(ctx context.Context, arg GetTripActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, getTripActivity, arg.ID, arg.TripID)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+	)
+	return i, err
+}
+
+const getTripAttachment = `-- name: GetTripAttachment :one
+SELECT
+    "id", "trip_id", "activity_id", "file_name", "content_type", "size_bytes", "storage_key", "uploaded_by", "created_at"
+FROM attachments
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type GetTripAttachmentParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetTripAttachment(ctx context.Context, arg GetTripAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, getTripAttachment, arg.ID, arg.TripID)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ActivityID,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.StorageKey,
+		&i.UploadedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTripAttachments = `-- name: GetTripAttachments :many
+SELECT
+    "id", "trip_id", "activity_id", "file_name", "content_type", "size_bytes", "storage_key", "uploaded_by", "created_at"
+FROM attachments
+WHERE
+    trip_id = $1
+    AND ($2::uuid IS NULL OR activity_id = $2)
+ORDER BY created_at, id
+`
+
+type GetTripAttachmentsParams struct {
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+	ActivityID pgtype.UUID `db:"activity_id" json:"activity_id"`
+}
+
+func (q *Queries) GetTripAttachments(ctx context.Context, arg GetTripAttachmentsParams) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getTripAttachments, arg.TripID, arg.ActivityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.ActivityID,
+			&i.FileName,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.StorageKey,
+			&i.UploadedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripForShare = `-- name: GetTripForShare :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
+WHERE
+    id = $1
+FOR SHARE
+`
+
+func (q *Queries) GetTripForShare(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTripForShare, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CancelledAt,
+		&i.CancellationReason,
+		&i.Status,
+		&i.Timezone,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
+const getTripForUpdate = `-- name: GetTripForUpdate :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetTripForUpdate(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTripForUpdate, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CancelledAt,
+		&i.CancellationReason,
+		&i.Status,
+		&i.Timezone,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "description", "category", "sort_order", "added_by", "created_at", "updated_at",
+    "preview_status", "preview_title", "preview_description", "preview_image_url", "preview_site_name",
+    "preview_attempts", "preview_next_attempt_at", "preview_fetched_at"
+FROM links
+WHERE
+    trip_id = $1
+ORDER BY sort_order, created_at, id
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.Description,
+			&i.Category,
+			&i.SortOrder,
+			&i.AddedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PreviewStatus,
+			&i.PreviewTitle,
+			&i.PreviewDescription,
+			&i.PreviewImageUrl,
+			&i.PreviewSiteName,
+			&i.PreviewAttempts,
+			&i.PreviewNextAttemptAt,
+			&i.PreviewFetchedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantIDByEmail = `-- name: GetTripParticipantIDByEmail :one
+SELECT
+    "id"
+FROM participants
+WHERE
+    trip_id = $1
+    AND lower(email) = lower($2)
+`
+
+type GetTripParticipantIDByEmailParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) GetTripParticipantIDByEmail(ctx context.Context, arg GetTripParticipantIDByEmailParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getTripParticipantIDByEmail, arg.TripID, arg.Email)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getTripParticipantRole = `-- name: GetTripParticipantRole :one
+SELECT
+    "role", "rsvp_status"
+FROM participants
+WHERE
+    trip_id = $1
+    AND (user_id = $2 OR lower(email) = lower($3))
+    AND rsvp_status <> 'declined'
+ORDER BY COALESCE(user_id = $2, false) DESC, id
+LIMIT 1
+`
+
+type GetTripParticipantRoleParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	UserID pgtype.UUID `db:"user_id" json:"user_id"`
+	Email  string      `db:"email" json:"email"`
+}
+
+type GetTripParticipantRoleRow struct {
+	Role       ParticipantRole `db:"role" json:"role"`
+	RsvpStatus RsvpStatus      `db:"rsvp_status" json:"rsvp_status"`
+}
+
+func (q *Queries) GetTripParticipantRole(ctx context.Context, arg GetTripParticipantRoleParams) (GetTripParticipantRoleRow, error) {
+	row := q.db.QueryRow(ctx, getTripParticipantRole, arg.TripID, arg.UserID, arg.Email)
+	var i GetTripParticipantRoleRow
+	err := row.Scan(&i.Role, &i.RsvpStatus)
+	return i, err
+}
+
+const getTripStatusTransitions = `-- name: GetTripStatusTransitions :many
+SELECT
+    "from_status", "to_status", "transitioned_at"
+FROM trip_status_transitions
+WHERE
+    trip_id = $1
+ORDER BY transitioned_at
+`
+
+type GetTripStatusTransitionsRow struct {
+	FromStatus     NullTripStatus     `db:"from_status" json:"from_status"`
+	ToStatus       TripStatus         `db:"to_status" json:"to_status"`
+	TransitionedAt pgtype.Timestamptz `db:"transitioned_at" json:"transitioned_at"`
+}
+
+func (q *Queries) GetTripStatusTransitions(ctx context.Context, tripID uuid.UUID) ([]GetTripStatusTransitionsRow, error) {
+	rows, err := q.db.Query(ctx, getTripStatusTransitions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripStatusTransitionsRow
+	for rows.Next() {
+		var i GetTripStatusTransitionsRow
+		if err := rows.Scan(&i.FromStatus, &i.ToStatus, &i.TransitionedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUser = `-- name: GetUser :one
+SELECT
+    "id", "email", "created_at", "last_login_at"
+FROM users
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const handOverParticipant = `-- name: HandOverParticipant :exec
+UPDATE participants
+SET
+    "email" = $2,
+    "name" = $3,
+    "user_id" = $4,
+    "phone" = NULL,
+    "notes" = NULL,
+    "rsvp_status" = 'accepted',
+    "responded_at" = now(),
+    "rsvp_comment" = NULL,
+    "role" = 'co_organiser'
 WHERE
     id = $1
 `
 
-func (q *Queries) ConfirmTrip(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmTrip, id)
+type HandOverParticipantParams struct {
+	ID     uuid.UUID   `db:"id" json:"id"`
+	Email  string      `db:"email" json:"email"`
+	Name   pgtype.Text `db:"name" json:"name"`
+	UserID pgtype.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) HandOverParticipant(ctx context.Context, arg HandOverParticipantParams) error {
+	_, err := q.db.Exec(ctx, handOverParticipant,
+		arg.ID,
+		arg.Email,
+		arg.Name,
+		arg.UserID,
+	)
 	return err
 }
 
-const createActivity = `-- name: CreateActivity :one
-INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+const insertTrip = `-- name: InsertTrip :one
+INSERT
+INTO trips
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "timezone") VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id"
+`
+
+type InsertTripParams struct {
+	Destination string             `db:"destination" json:"destination"`
+	OwnerEmail  string             `db:"owner_email" json:"owner_email"`
+	OwnerName   string             `db:"owner_name" json:"owner_name"`
+	StartsAt    pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	EndsAt      pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	Timezone    string             `db:"timezone" json:"timezone"`
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Timezone,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
+INSERT 
+INTO participants
+    ( "trip_id", "email", "name", "phone", "notes" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+ON CONFLICT (trip_id, lower(email)) DO NOTHING
 RETURNING "id"
 `
 
-type CreateActivityParams struct {
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+type InviteParticipantToTripParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Email  string      `db:"email" json:"email"`
+	Name   pgtype.Text `db:"name" json:"name"`
+	Phone  pgtype.Text `db:"phone" json:"phone"`
+	Notes  pgtype.Text `db:"notes" json:"notes"`
 }
 
-func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
+func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, inviteParticipantToTrip,
+		arg.TripID,
+		arg.Email,
+		arg.Name,
+		arg.Phone,
+		arg.Notes,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
-const createTripLink = `-- name: CreateTripLink :one
-INSERT INTO links
-    ( "trip_id", "title", "url" ) VALUES
-    ( $1, $2, $3 )
-RETURNING "id"
+type InviteParticipantsToTripParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Email  string      `db:"email" json:"email"`
+	Name   pgtype.Text `db:"name" json:"name"`
+}
+
+const linkUserParticipants = `-- name: LinkUserParticipants :execrows
+UPDATE participants
+SET
+    "user_id" = $1
+WHERE
+    lower(email) = lower($2)
+    AND user_id IS NULL
 `
 
-type CreateTripLinkParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+type LinkUserParticipantsParams struct {
+	UserID pgtype.UUID `db:"user_id" json:"user_id"`
+	Email  string      `db:"email" json:"email"`
 }
 
-func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) LinkUserParticipants(ctx context.Context, arg LinkUserParticipantsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, linkUserParticipants, arg.UserID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const getParticipant = `-- name: GetParticipant :one
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+const linkUserTrips = `-- name: LinkUserTrips :execrows
+UPDATE trips
+SET
+    "owner_id" = $1
 WHERE
-    id = $1
+    lower(owner_email) = lower($2)
+    AND owner_id IS NULL
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.TripID,
-		&i.Email,
-		&i.IsConfirmed,
-	)
-	return i, err
+type LinkUserTripsParams struct {
+	UserID pgtype.UUID `db:"user_id" json:"user_id"`
+	Email  string      `db:"email" json:"email"`
 }
 
-const getParticipants = `-- name: GetParticipants :many
+func (q *Queries) LinkUserTrips(ctx context.Context, arg LinkUserTripsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, linkUserTrips, arg.UserID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listUserTrips = `-- name: ListUserTrips :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
 WHERE
-    trip_id = $1
+    id IN (
+        SELECT t.id FROM trips t WHERE t.owner_id = $1
+        UNION
+        SELECT t.id FROM trips t WHERE lower(t.owner_email) = lower($2)
+        UNION
+        SELECT p.trip_id FROM participants p WHERE p.user_id = $1 AND p.rsvp_status <> 'declined'
+        UNION
+        SELECT p.trip_id FROM participants p WHERE lower(p.email) = lower($2) AND p.rsvp_status <> 'declined'
+    )
+    AND ($3::trip_status IS NULL OR status = $3)
+    AND (NOT $4::bool OR ends_at > now())
+    AND (NOT $5::bool OR ends_at <= now())
+    AND ($6::text IS NULL OR destination ILIKE '%' || $6 || '%')
+    AND (
+        $7::timestamptz IS NULL
+        OR (NOT $8::bool AND (starts_at, id) > ($7, $9::uuid))
+        OR ($8 AND (starts_at, id) < ($7, $9::uuid))
+    )
+ORDER BY
+    CASE WHEN $8 THEN starts_at END DESC,
+    CASE WHEN $8 THEN id END DESC,
+    starts_at,
+    id
+LIMIT $10
 `
 
-func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, tripID)
+type ListUserTripsParams struct {
+	UserID        pgtype.UUID        `db:"user_id" json:"user_id"`
+	Email         string             `db:"email" json:"email"`
+	Status        NullTripStatus     `db:"status" json:"status"`
+	Upcoming      bool               `db:"upcoming" json:"upcoming"`
+	Past          bool               `db:"past" json:"past"`
+	Destination   pgtype.Text        `db:"destination" json:"destination"`
+	AfterStartsAt pgtype.Timestamptz `db:"after_starts_at" json:"after_starts_at"`
+	Descending    bool               `db:"descending" json:"descending"`
+	AfterID       pgtype.UUID        `db:"after_id" json:"after_id"`
+	RowLimit      int32              `db:"row_limit" json:"row_limit"`
+}
+
+func (q *Queries) ListUserTrips(ctx context.Context, arg ListUserTripsParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listUserTrips,
+		arg.UserID,
+		arg.Email,
+		arg.Status,
+		arg.Upcoming,
+		arg.Past,
+		arg.Destination,
+		arg.AfterStartsAt,
+		arg.Descending,
+		arg.AfterID,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Participant
+	var items []Trip
 	for rows.Next() {
-		var i Participant
+		var i Trip
 		if err := rows.Scan(
 			&i.ID,
-			&i.TripID,
-			&i.Email,
-			&i.IsConfirmed,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CancelledAt,
+			&i.CancellationReason,
+			&i.Status,
+			&i.Timezone,
+			&i.OwnerID,
 		); err != nil {
 			return nil, err
 		}
@@ -131,88 +1482,205 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 	return items, nil
 }
 
-const getTrip = `-- name: GetTrip :one
-SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
-FROM trips
+const markOutboxMessageSent = `-- name: MarkOutboxMessageSent :exec
+UPDATE outbox
+SET
+    "status" = 'sent',
+    "sent_at" = now(),
+    "last_error" = NULL
 WHERE
     id = $1
 `
 
-func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
-	row := q.db.QueryRow(ctx, getTrip, id)
-	var i Trip
-	err := row.Scan(
-		&i.ID,
-		&i.Destination,
-		&i.OwnerEmail,
-		&i.OwnerName,
-		&i.IsConfirmed,
-		&i.StartsAt,
-		&i.EndsAt,
+func (q *Queries) MarkOutboxMessageSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markOutboxMessageSent, id)
+	return err
+}
+
+const markTripCancelled = `-- name: MarkTripCancelled :exec
+UPDATE trips
+SET
+    "cancelled_at" = now(),
+    "cancellation_reason" = $2
+WHERE
+    id = $1
+`
+
+type MarkTripCancelledParams struct {
+	ID                 uuid.UUID   `db:"id" json:"id"`
+	CancellationReason pgtype.Text `db:"cancellation_reason" json:"cancellation_reason"`
+}
+
+func (q *Queries) MarkTripCancelled(ctx context.Context, arg MarkTripCancelledParams) error {
+	_, err := q.db.Exec(ctx, markTripCancelled, arg.ID, arg.CancellationReason)
+	return err
+}
+
+const recordTripCreated = `-- name: RecordTripCreated :exec
+INSERT INTO trip_status_transitions
+    ( "trip_id", "to_status" ) VALUES
+    ( $1, 'draft' )
+`
+
+func (q *Queries) RecordTripCreated(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, recordTripCreated, tripID)
+	return err
+}
+
+const respondToInvitation = `-- name: RespondToInvitation :exec
+UPDATE participants
+SET 
+    "rsvp_status" = $1,
+    "responded_at" = now(),
+    "rsvp_comment" = $2,
+    "name" = COALESCE($3, "name"),
+    "phone" = COALESCE($4, "phone"),
+    "notes" = COALESCE($5, "notes")
+WHERE
+    id = $6
+`
+
+type RespondToInvitationParams struct {
+	RsvpStatus  RsvpStatus  `db:"rsvp_status" json:"rsvp_status"`
+	RsvpComment pgtype.Text `db:"rsvp_comment" json:"rsvp_comment"`
+	Name        pgtype.Text `db:"name" json:"name"`
+	Phone       pgtype.Text `db:"phone" json:"phone"`
+	Notes       pgtype.Text `db:"notes" json:"notes"`
+	ID          uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) RespondToInvitation(ctx context.Context, arg RespondToInvitationParams) error {
+	_, err := q.db.Exec(ctx, respondToInvitation,
+		arg.RsvpStatus,
+		arg.RsvpComment,
+		arg.Name,
+		arg.Phone,
+		arg.Notes,
+		arg.ID,
 	)
-	return i, err
+	return err
 }
 
-const getTripActivities = `-- name: GetTripActivities :many
-SELECT
-    "id", "trip_id", "title", "occurs_at"
-FROM activities
+const retryLinkPreview = `-- name: RetryLinkPreview :exec
+UPDATE links
+SET
+    "preview_next_attempt_at" = $1
 WHERE
-    trip_id = $1
+    id = $2
+    AND url = $3
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Activity
-	for rows.Next() {
-		var i Activity
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.OccursAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type RetryLinkPreviewParams struct {
+	PreviewNextAttemptAt pgtype.Timestamptz `db:"preview_next_attempt_at" json:"preview_next_attempt_at"`
+	ID                   uuid.UUID          `db:"id" json:"id"`
+	Url                  string             `db:"url" json:"url"`
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
-SELECT
-    "id", "trip_id", "title", "url"
-FROM links
+func (q *Queries) RetryLinkPreview(ctx context.Context, arg RetryLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, retryLinkPreview, arg.PreviewNextAttemptAt, arg.ID, arg.Url)
+	return err
+}
+
+const retryOutboxMessage = `-- name: RetryOutboxMessage :exec
+UPDATE outbox
+SET
+    "next_attempt_at" = $1,
+    "last_error" = $2
 WHERE
-    trip_id = $1
+    id = $3
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+type RetryOutboxMessageParams struct {
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastError     pgtype.Text        `db:"last_error" json:"last_error"`
+	ID            uuid.UUID          `db:"id" json:"id"`
+}
+
+func (q *Queries) RetryOutboxMessage(ctx context.Context, arg RetryOutboxMessageParams) error {
+	_, err := q.db.Exec(ctx, retryOutboxMessage, arg.NextAttemptAt, arg.LastError, arg.ID)
+	return err
+}
+
+const revokeParticipantTokens = `-- name: RevokeParticipantTokens :exec
+UPDATE confirmation_tokens
+SET
+    "used_at" = now()
+WHERE
+    participant_id = $1
+    AND used_at IS NULL
+`
+
+func (q *Queries) RevokeParticipantTokens(ctx context.Context, participantID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, revokeParticipantTokens, participantID)
+	return err
+}
+
+const saveLinkPreview = `-- name: SaveLinkPreview :exec
+UPDATE links
+SET
+    "preview_status" = 'ready',
+    "preview_title" = $1,
+    "preview_description" = $2,
+    "preview_image_url" = $3,
+    "preview_site_name" = $4,
+    "preview_fetched_at" = now()
+WHERE
+    id = $5
+    AND url = $6
+`
+
+type SaveLinkPreviewParams struct {
+	PreviewTitle       pgtype.Text `db:"preview_title" json:"preview_title"`
+	PreviewDescription pgtype.Text `db:"preview_description" json:"preview_description"`
+	PreviewImageUrl    pgtype.Text `db:"preview_image_url" json:"preview_image_url"`
+	PreviewSiteName    pgtype.Text `db:"preview_site_name" json:"preview_site_name"`
+	ID                 uuid.UUID   `db:"id" json:"id"`
+	Url                string      `db:"url" json:"url"`
+}
+
+func (q *Queries) SaveLinkPreview(ctx context.Context, arg SaveLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, saveLinkPreview,
+		arg.PreviewTitle,
+		arg.PreviewDescription,
+		arg.PreviewImageUrl,
+		arg.PreviewSiteName,
+		arg.ID,
+		arg.Url,
+	)
+	return err
+}
+
+const startDueTrips = `-- name: StartDueTrips :many
+WITH started AS (
+    UPDATE trips
+    SET
+        "status" = 'in_progress'
+    WHERE
+        status = 'confirmed'
+        AND starts_at <= now()
+    RETURNING "id"
+)
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, 'confirmed', 'in_progress'
+FROM started
+RETURNING "trip_id"
+`
+
+func (q *Queries) StartDueTrips(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, startDueTrips)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Link
+	var items []uuid.UUID
 	for rows.Next() {
-		var i Link
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.Url,
-		); err != nil {
+		var trip_id uuid.UUID
+		if err := rows.Scan(&trip_id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, trip_id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -220,58 +1688,84 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 	return items, nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT
-INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
-RETURNING "id"
+const transferTripOwner = `-- name: TransferTripOwner :exec
+UPDATE trips
+SET
+    "owner_email" = $2,
+    "owner_name" = $3,
+    "owner_id" = $4
+WHERE
+    id = $1
 `
 
-type InsertTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+type TransferTripOwnerParams struct {
+	ID         uuid.UUID   `db:"id" json:"id"`
+	OwnerEmail string      `db:"owner_email" json:"owner_email"`
+	OwnerName  string      `db:"owner_name" json:"owner_name"`
+	OwnerID    pgtype.UUID `db:"owner_id" json:"owner_id"`
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
+func (q *Queries) TransferTripOwner(ctx context.Context, arg TransferTripOwnerParams) error {
+	_, err := q.db.Exec(ctx, transferTripOwner,
+		arg.ID,
 		arg.OwnerEmail,
 		arg.OwnerName,
-		arg.StartsAt,
-		arg.EndsAt,
+		arg.OwnerID,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	return err
 }
 
-const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
-INSERT 
-INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 )
-RETURNING "id"
+const updateActivity = `-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = COALESCE($1, "title"),
+    "occurs_at" = COALESCE($2, "occurs_at")
+WHERE
+    id = $3
+    AND trip_id = $4
 `
 
-type InviteParticipantToTripParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
+type UpdateActivityParams struct {
+	Title    pgtype.Text        `db:"title" json:"title"`
+	OccursAt pgtype.Timestamptz `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID          `db:"id" json:"id"`
+	TripID   uuid.UUID          `db:"trip_id" json:"trip_id"`
 }
 
-func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, inviteParticipantToTrip, arg.TripID, arg.Email)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-type InviteParticipantsToTripParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
+const updateParticipantRole = `-- name: UpdateParticipantRole :execrows
+UPDATE participants
+SET
+    "role" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+`
+
+type UpdateParticipantRoleParams struct {
+	Role   ParticipantRole `db:"role" json:"role"`
+	ID     uuid.UUID       `db:"id" json:"id"`
+	TripID uuid.UUID       `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateParticipantRole(ctx context.Context, arg UpdateParticipantRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateParticipantRole, arg.Role, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const updateTrip = `-- name: UpdateTrip :exec
@@ -280,16 +1774,17 @@ SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = false
+    "timezone" = COALESCE($4, "timezone")
 WHERE
-    id = $4
+    id = $5
 `
 
 type UpdateTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	ID          uuid.UUID        `db:"id" json:"id"`
+	Destination string             `db:"destination" json:"destination"`
+	EndsAt      pgtype.Timestamptz `db:"ends_at" json:"ends_at"`
+	StartsAt    pgtype.Timestamptz `db:"starts_at" json:"starts_at"`
+	Timezone    pgtype.Text        `db:"timezone" json:"timezone"`
+	ID          uuid.UUID          `db:"id" json:"id"`
 }
 
 func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
@@ -297,7 +1792,117 @@ func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
 		arg.Destination,
 		arg.EndsAt,
 		arg.StartsAt,
+		arg.Timezone,
 		arg.ID,
 	)
 	return err
 }
+
+const updateTripLink = `-- name: UpdateTripLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "description" = $3,
+    "category" = $4,
+    "sort_order" = $5,
+    "updated_at" = now(),
+    -- A new URL needs a new preview.
+    "preview_status" = CASE WHEN "url" = $2 THEN "preview_status" ELSE 'pending' END,
+    "preview_title" = CASE WHEN "url" = $2 THEN "preview_title" END,
+    "preview_description" = CASE WHEN "url" = $2 THEN "preview_description" END,
+    "preview_image_url" = CASE WHEN "url" = $2 THEN "preview_image_url" END,
+    "preview_site_name" = CASE WHEN "url" = $2 THEN "preview_site_name" END,
+    "preview_attempts" = CASE WHEN "url" = $2 THEN "preview_attempts" ELSE 0 END,
+    "preview_next_attempt_at" = CASE WHEN "url" = $2 THEN "preview_next_attempt_at" ELSE now() END,
+    "preview_fetched_at" = CASE WHEN "url" = $2 THEN "preview_fetched_at" END
+WHERE
+    id = $6
+    AND trip_id = $7
+`
+
+type UpdateTripLinkParams struct {
+	Title       string           `db:"title" json:"title"`
+	Url         string           `db:"url" json:"url"`
+	Description pgtype.Text      `db:"description" json:"description"`
+	Category    NullLinkCategory `db:"category" json:"category"`
+	SortOrder   int32            `db:"sort_order" json:"sort_order"`
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateTripLink(ctx context.Context, arg UpdateTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripLink,
+		arg.Title,
+		arg.Url,
+		arg.Description,
+		arg.Category,
+		arg.SortOrder,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripStatus = `-- name: UpdateTripStatus :execrows
+WITH previous AS (
+    SELECT "id", "status"
+    FROM trips
+    WHERE
+        id = $1
+    FOR UPDATE
+), updated AS (
+    UPDATE trips
+    SET
+        "status" = $2::trip_status
+    FROM previous
+    WHERE
+        trips.id = previous.id
+        AND previous.status::text = ANY($3::text[])
+    RETURNING trips.id, previous.status
+)
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, status, $2::trip_status
+FROM updated
+`
+
+type UpdateTripStatusParams struct {
+	ID           uuid.UUID  `db:"id" json:"id"`
+	ToStatus     TripStatus `db:"to_status" json:"to_status"`
+	FromStatuses []string   `db:"from_statuses" json:"from_statuses"`
+}
+
+func (q *Queries) UpdateTripStatus(ctx context.Context, arg UpdateTripStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripStatus, arg.ID, arg.ToStatus, arg.FromStatuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertUser = `-- name: UpsertUser :one
+INSERT INTO users
+    ( "email", "last_login_at" ) VALUES
+    ( $1, now() )
+ON CONFLICT ((lower(email))) DO UPDATE
+SET
+    "last_login_at" = EXCLUDED.last_login_at
+RETURNING "id", "email", "created_at", "last_login_at"
+`
+
+func (q *Queries) UpsertUser(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, upsertUser, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
@@ -1,51 +1,190 @@
 -- name: InsertTrip :one
 INSERT
 INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "timezone") VALUES
+    ( $1, $2, $3, $4, $5, $6 )
 RETURNING "id";
 
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
 FROM trips
 WHERE
     id = $1;
 
+-- name: GetTripForShare :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
+WHERE
+    id = $1
+FOR SHARE;
+
+-- name: GetTripForUpdate :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: ListUserTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "cancelled_at", "cancellation_reason", "status", "timezone", "owner_id"
+FROM trips
+WHERE
+    id IN (
+        SELECT t.id FROM trips t WHERE t.owner_id = @user_id
+        UNION
+        SELECT t.id FROM trips t WHERE lower(t.owner_email) = lower(@email)
+        UNION
+        SELECT p.trip_id FROM participants p WHERE p.user_id = @user_id AND p.rsvp_status <> 'declined'
+        UNION
+        SELECT p.trip_id FROM participants p WHERE lower(p.email) = lower(@email) AND p.rsvp_status <> 'declined'
+    )
+    AND (sqlc.narg('status')::trip_status IS NULL OR status = sqlc.narg('status'))
+    AND (NOT @upcoming::bool OR ends_at > now())
+    AND (NOT @past::bool OR ends_at <= now())
+    AND (sqlc.narg('destination')::text IS NULL OR destination ILIKE '%' || sqlc.narg('destination') || '%')
+    AND (
+        sqlc.narg('after_starts_at')::timestamptz IS NULL
+        OR (NOT @descending::bool AND (starts_at, id) > (sqlc.narg('after_starts_at'), sqlc.narg('after_id')::uuid))
+        OR (@descending AND (starts_at, id) < (sqlc.narg('after_starts_at'), sqlc.narg('after_id')::uuid))
+    )
+ORDER BY
+    CASE WHEN @descending THEN starts_at END DESC,
+    CASE WHEN @descending THEN id END DESC,
+    starts_at,
+    id
+LIMIT @row_limit;
+
 -- name: UpdateTrip :exec
 UPDATE trips
 SET 
-    "destination" = $1,
-    "ends_at" = $2,
-    "starts_at" = $3,
-    "is_confirmed" = false
+    "destination" = @destination,
+    "ends_at" = @ends_at,
+    "starts_at" = @starts_at,
+    "timezone" = COALESCE(sqlc.narg('timezone'), "timezone")
+WHERE
+    id = @id;
+
+-- name: RecordTripCreated :exec
+INSERT INTO trip_status_transitions
+    ( "trip_id", "to_status" ) VALUES
+    ( $1, 'draft' );
+
+-- name: UpdateTripStatus :execrows
+WITH previous AS (
+    SELECT "id", "status"
+    FROM trips
+    WHERE
+        id = @id
+    FOR UPDATE
+), updated AS (
+    UPDATE trips
+    SET
+        "status" = @to_status::trip_status
+    FROM previous
+    WHERE
+        trips.id = previous.id
+        AND previous.status::text = ANY(@from_statuses::text[])
+    RETURNING trips.id, previous.status
+)
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, status, @to_status::trip_status
+FROM updated;
+
+-- name: GetTripStatusTransitions :many
+SELECT
+    "from_status", "to_status", "transitioned_at"
+FROM trip_status_transitions
 WHERE
-    id = $4;
+    trip_id = $1
+ORDER BY transitioned_at;
 
--- name: ConfirmTrip :exec
+-- name: StartDueTrips :many
+WITH started AS (
+    UPDATE trips
+    SET
+        "status" = 'in_progress'
+    WHERE
+        status = 'confirmed'
+        AND starts_at <= now()
+    RETURNING "id"
+)
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, 'confirmed', 'in_progress'
+FROM started
+RETURNING "trip_id";
+
+-- name: CompleteDueTrips :many
+WITH completed AS (
+    UPDATE trips
+    SET
+        "status" = 'completed'
+    WHERE
+        status = 'in_progress'
+        AND ends_at <= now()
+    RETURNING "id"
+)
+INSERT INTO trip_status_transitions
+    ( "trip_id", "from_status", "to_status" )
+SELECT
+    id, 'in_progress', 'completed'
+FROM completed
+RETURNING "trip_id";
+
+-- name: DeleteDraftTrip :one
+DELETE FROM trips
+WHERE
+    id = $1
+    AND status IN ('draft', 'pending_confirmation')
+RETURNING "id";
+
+-- name: MarkTripCancelled :exec
 UPDATE trips
-SET 
-    "is_confirmed" = true
+SET
+    "cancelled_at" = now(),
+    "cancellation_reason" = $2
 WHERE
     id = $1;
 
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "name", "phone", "notes", "rsvp_status", "responded_at", "rsvp_comment", "user_id", "role"
 FROM participants
 WHERE
     id = $1;
 
--- name: ConfirmParticipant :exec
+-- name: RespondToInvitation :exec
 UPDATE participants
 SET 
-    "is_confirmed" = true
+    "rsvp_status" = sqlc.arg('rsvp_status'),
+    "responded_at" = now(),
+    "rsvp_comment" = sqlc.narg('rsvp_comment'),
+    "name" = COALESCE(sqlc.narg('name'), "name"),
+    "phone" = COALESCE(sqlc.narg('phone'), "phone"),
+    "notes" = COALESCE(sqlc.narg('notes'), "notes")
 WHERE
-    id = $1;
+    id = sqlc.arg('id');
 
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "name", "phone", "notes", "rsvp_status", "responded_at", "rsvp_comment", "user_id", "role"
+FROM participants
+WHERE
+    trip_id = $1;
+
+-- name: CountTripRsvps :one
+SELECT
+    COUNT(*) FILTER (WHERE rsvp_status = 'pending') AS "pending",
+    COUNT(*) FILTER (WHERE rsvp_status = 'accepted') AS "accepted",
+    COUNT(*) FILTER (WHERE rsvp_status = 'declined') AS "declined",
+    COUNT(*) FILTER (WHERE rsvp_status = 'maybe') AS "maybe"
 FROM participants
 WHERE
     trip_id = $1;
@@ -53,14 +192,38 @@ WHERE
 -- name: InviteParticipantToTrip :one
 INSERT 
 INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 )
+    ( "trip_id", "email", "name", "phone", "notes" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+ON CONFLICT (trip_id, lower(email)) DO NOTHING
 RETURNING "id";
 
+-- name: GetTripParticipantIDByEmail :one
+SELECT
+    "id"
+FROM participants
+WHERE
+    trip_id = $1
+    AND lower(email) = lower(@email);
+
+-- name: DeleteParticipant :one
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+RETURNING "email", "name", "rsvp_status";
+
+-- name: DeletePendingParticipant :one
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND rsvp_status = 'pending'
+RETURNING "email", "name";
+
 -- name: InviteParticipantsToTrip :copyfrom
 INSERT INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 );
+    ( "trip_id", "email", "name" ) VALUES
+    ( $1, $2, $3 );
 
 -- name: CreateActivity :one
 INSERT INTO activities
@@ -73,17 +236,406 @@ SELECT
     "id", "trip_id", "title", "occurs_at"
 FROM activities
 WHERE
-    trip_id = $1;
+    trip_id = $1
+ORDER BY occurs_at;
+
+-- name: GetOrphanedActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    trip_id = @trip_id
+    AND occurs_at BETWEEN @old_starts_at AND @old_ends_at
+    AND (occurs_at < @starts_at OR occurs_at > @ends_at)
+ORDER BY occurs_at;
+
+-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = COALESCE(sqlc.narg('title'), "title"),
+    "occurs_at" = COALESCE(sqlc.narg('occurs_at'), "occurs_at")
+WHERE
+    id = @id
+    AND trip_id = @trip_id;
+
+-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2;
 
 -- name: CreateTripLink :one
 INSERT INTO links
-    ( "trip_id", "title", "url" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "url", "description", "category", "sort_order", "added_by" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "description", "category", "sort_order", "added_by", "created_at", "updated_at",
+    "preview_status", "preview_title", "preview_description", "preview_image_url", "preview_site_name",
+    "preview_attempts", "preview_next_attempt_at", "preview_fetched_at"
 FROM links
 WHERE
-    trip_id = $1;
\ No newline at end of file
+    trip_id = $1
+ORDER BY sort_order, created_at, id;
+
+-- name: UpdateTripLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "description" = $3,
+    "category" = $4,
+    "sort_order" = $5,
+    "updated_at" = now(),
+    -- A new URL needs a new preview.
+    "preview_status" = CASE WHEN "url" = $2 THEN "preview_status" ELSE 'pending' END,
+    "preview_title" = CASE WHEN "url" = $2 THEN "preview_title" END,
+    "preview_description" = CASE WHEN "url" = $2 THEN "preview_description" END,
+    "preview_image_url" = CASE WHEN "url" = $2 THEN "preview_image_url" END,
+    "preview_site_name" = CASE WHEN "url" = $2 THEN "preview_site_name" END,
+    "preview_attempts" = CASE WHEN "url" = $2 THEN "preview_attempts" ELSE 0 END,
+    "preview_next_attempt_at" = CASE WHEN "url" = $2 THEN "preview_next_attempt_at" ELSE now() END,
+    "preview_fetched_at" = CASE WHEN "url" = $2 THEN "preview_fetched_at" END
+WHERE
+    id = $6
+    AND trip_id = $7;
+
+-- name: ClaimLinkPreviews :many
+UPDATE links
+SET
+    "preview_attempts" = preview_attempts + 1,
+    "preview_next_attempt_at" = now() + make_interval(secs => @lease_seconds::float8)
+WHERE
+    id IN (
+        SELECT id
+        FROM links
+        WHERE
+            preview_status = 'pending'
+            AND preview_next_attempt_at <= now()
+        ORDER BY preview_next_attempt_at
+        LIMIT @batch_size
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "url", "preview_attempts";
+
+-- name: SaveLinkPreview :exec
+UPDATE links
+SET
+    "preview_status" = 'ready',
+    "preview_title" = $1,
+    "preview_description" = $2,
+    "preview_image_url" = $3,
+    "preview_site_name" = $4,
+    "preview_fetched_at" = now()
+WHERE
+    id = $5
+    AND url = $6;
+
+-- name: RetryLinkPreview :exec
+UPDATE links
+SET
+    "preview_next_attempt_at" = $1
+WHERE
+    id = $2
+    AND url = $3;
+
+-- name: FailLinkPreview :exec
+UPDATE links
+SET
+    "preview_status" = 'failed',
+    "preview_fetched_at" = now()
+WHERE
+    id = $1
+    AND url = $2;
+
+-- name: DeleteTripLink :execrows
+DELETE FROM links
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: GetTripActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at"
+FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: CreateAttachment :exec
+INSERT INTO attachments
+    ( "id", "trip_id", "activity_id", "file_name", "content_type", "size_bytes", "storage_key", "uploaded_by" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 );
+
+-- name: GetTripAttachments :many
+SELECT
+    "id", "trip_id", "activity_id", "file_name", "content_type", "size_bytes", "storage_key", "uploaded_by", "created_at"
+FROM attachments
+WHERE
+    trip_id = @trip_id
+    AND (sqlc.narg('activity_id')::uuid IS NULL OR activity_id = sqlc.narg('activity_id'))
+ORDER BY created_at, id;
+
+-- name: GetTripAttachment :one
+SELECT
+    "id", "trip_id", "activity_id", "file_name", "content_type", "size_bytes", "storage_key", "uploaded_by", "created_at"
+FROM attachments
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: DeleteTripAttachment :execrows
+DELETE FROM attachments
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: CreateConfirmationToken :exec
+INSERT INTO confirmation_tokens
+    ( "token_hash", "purpose", "trip_id", "participant_id", "expires_at" ) VALUES
+    ( $1, $2, $3, $4, $5 );
+
+-- name: ConsumeConfirmationToken :one
+UPDATE confirmation_tokens
+SET
+    "used_at" = now()
+WHERE
+    token_hash = $1
+    AND purpose = $2
+    AND used_at IS NULL
+    AND expires_at > now()
+RETURNING "trip_id", "participant_id";
+
+-- name: GetConfirmationToken :one
+SELECT
+    "trip_id", "participant_id"
+FROM confirmation_tokens
+WHERE
+    token_hash = $1
+    AND purpose = $2
+    AND used_at IS NULL
+    AND expires_at > now();
+
+-- name: EnqueueOutboxMessage :exec
+INSERT INTO outbox
+    ( "kind", "payload" ) VALUES
+    ( $1, $2 );
+
+-- name: EnqueueOutboxMessages :copyfrom
+INSERT INTO outbox
+    ( "kind", "payload" ) VALUES
+    ( $1, $2 );
+
+-- name: ClaimOutboxMessages :many
+UPDATE outbox
+SET
+    "attempts" = attempts + 1,
+    "next_attempt_at" = now() + make_interval(secs => @lease_seconds::float8)
+WHERE
+    id IN (
+        SELECT id
+        FROM outbox
+        WHERE
+            status = 'pending'
+            AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT @batch_size
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "kind", "payload", "attempts";
+
+-- name: MarkOutboxMessageSent :exec
+UPDATE outbox
+SET
+    "status" = 'sent',
+    "sent_at" = now(),
+    "last_error" = NULL
+WHERE
+    id = $1;
+
+-- name: RetryOutboxMessage :exec
+UPDATE outbox
+SET
+    "next_attempt_at" = $1,
+    "last_error" = $2
+WHERE
+    id = $3;
+
+-- name: DeadLetterOutboxMessage :exec
+UPDATE outbox
+SET
+    "status" = 'dead',
+    "last_error" = $1
+WHERE
+    id = $2;
+
+-- name: CancelParticipantOutboxMessages :exec
+DELETE FROM outbox
+WHERE
+    status = 'pending'
+    AND payload->>'participant_id' = @participant_id::text;
+
+-- name: CancelTripOutboxMessages :exec
+DELETE FROM outbox
+WHERE
+    status = 'pending'
+    AND payload->>'trip_id' = @trip_id::text;
+
+-- name: CreateLoginToken :exec
+INSERT INTO login_tokens
+    ( "id", "token_hash", "email", "expires_at" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: ConsumeLoginToken :one
+UPDATE login_tokens
+SET
+    "used_at" = now()
+WHERE
+    id = $1
+    AND token_hash = $2
+    AND used_at IS NULL
+    AND expires_at > now()
+RETURNING "email";
+
+-- name: GetLoginToken :one
+SELECT
+    "email", "expires_at"
+FROM login_tokens
+WHERE
+    id = $1
+    AND token_hash = $2
+    AND used_at IS NULL
+    AND expires_at > now();
+
+-- name: UpsertUser :one
+INSERT INTO users
+    ( "email", "last_login_at" ) VALUES
+    ( $1, now() )
+ON CONFLICT ((lower(email))) DO UPDATE
+SET
+    "last_login_at" = EXCLUDED.last_login_at
+RETURNING "id", "email", "created_at", "last_login_at";
+
+-- name: GetUser :one
+SELECT
+    "id", "email", "created_at", "last_login_at"
+FROM users
+WHERE
+    id = $1;
+
+-- name: LinkUserTrips :execrows
+UPDATE trips
+SET
+    "owner_id" = @user_id
+WHERE
+    lower(owner_email) = lower(@email)
+    AND owner_id IS NULL;
+
+-- name: LinkUserParticipants :execrows
+UPDATE participants
+SET
+    "user_id" = @user_id
+WHERE
+    lower(email) = lower(@email)
+    AND user_id IS NULL;
+
+-- name: GetTripParticipantRole :one
+SELECT
+    "role", "rsvp_status"
+FROM participants
+WHERE
+    trip_id = @trip_id
+    AND (user_id = @user_id OR lower(email) = lower(@email))
+    AND rsvp_status <> 'declined'
+ORDER BY COALESCE(user_id = @user_id, false) DESC, id
+LIMIT 1;
+
+-- name: UpdateParticipantRole :execrows
+UPDATE participants
+SET
+    "role" = $1
+WHERE
+    id = $2
+    AND trip_id = $3;
+
+-- name: GetIdentityUserID :one
+SELECT
+    "user_id"
+FROM user_identities
+WHERE
+    issuer = $1
+    AND subject = $2;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities
+    ( "user_id", "issuer", "subject" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (issuer, subject) DO NOTHING;
+
+-- name: GetPendingOwnershipTransfer :one
+SELECT
+    "id", "trip_id", "participant_id", "from_email", "created_at", "accepted_at", "cancelled_at"
+FROM ownership_transfers
+WHERE
+    trip_id = $1
+    AND accepted_at IS NULL
+    AND cancelled_at IS NULL;
+
+-- name: CreateOwnershipTransfer :exec
+INSERT INTO ownership_transfers
+    ( "trip_id", "participant_id", "from_email" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: CancelOwnershipTransfers :execrows
+UPDATE ownership_transfers
+SET
+    "cancelled_at" = now()
+WHERE
+    trip_id = $1
+    AND accepted_at IS NULL
+    AND cancelled_at IS NULL;
+
+-- name: AcceptOwnershipTransfer :one
+UPDATE ownership_transfers
+SET
+    "accepted_at" = now()
+WHERE
+    trip_id = $1
+    AND participant_id = $2
+    AND accepted_at IS NULL
+    AND cancelled_at IS NULL
+RETURNING "from_email";
+
+-- name: TransferTripOwner :exec
+UPDATE trips
+SET
+    "owner_email" = $2,
+    "owner_name" = $3,
+    "owner_id" = $4
+WHERE
+    id = $1;
+
+-- name: HandOverParticipant :exec
+UPDATE participants
+SET
+    "email" = $2,
+    "name" = $3,
+    "user_id" = $4,
+    "phone" = NULL,
+    "notes" = NULL,
+    "rsvp_status" = 'accepted',
+    "responded_at" = now(),
+    "rsvp_comment" = NULL,
+    "role" = 'co_organiser'
+WHERE
+    id = $1;
+
+-- name: RevokeParticipantTokens :exec
+UPDATE confirmation_tokens
+SET
+    "used_at" = now()
+WHERE
+    participant_id = $1
+    AND used_at IS NULL;
@@ -0,0 +1,37 @@
+package pgstore
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DefaultTimezone is the zone of trips created without one.
+const DefaultTimezone = "UTC"
+
+// Location returns the time zone the trip happens in. Zones are validated
+// before they are stored, so the UTC fallback only covers a zone the running
+// binary does not know about.
+func (t Trip) Location() *time.Location {
+	loc, err := time.LoadLocation(t.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func timezoneOrUTC(s *string) string {
+	if s == nil || *s == "" {
+		return DefaultTimezone
+	}
+	return *s
+}
+
+// optionalTimezone maps an omitted or empty zone to NULL, which keeps the one
+// the trip already has.
+func optionalTimezone(s *string) pgtype.Text {
+	if s == nil || *s == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
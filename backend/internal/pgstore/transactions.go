@@ -2,10 +2,15 @@ package pgstore
 
 import (
 	"SwallowGo/internal/api/spec"
+	"SwallowGo/internal/tokens"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -23,27 +28,45 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 		Destination: params.Destination,
 		OwnerEmail:  string(params.OwnerEmail),
 		OwnerName:   params.OwnerName,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		StartsAt:    pgtype.Timestamptz{Valid: true, Time: params.StartsAt},
+		EndsAt:      pgtype.Timestamptz{Valid: true, Time: params.EndsAt},
+		Timezone:    timezoneOrUTC(params.Timezone),
 	})
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w", err)
 	}
 
-	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
-	for i, eti := range params.EmailsToInvite {
-		participants[i] = InviteParticipantsToTripParams{
+	if err := qtx.RecordTripCreated(ctx, tripID); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to record status for CreateTrip: %w", err)
+	}
+
+	// COPY cannot skip conflicting rows, so duplicates are dropped up front.
+	// The owner is not a participant of their own trip.
+	seen := map[string]bool{strings.ToLower(string(params.OwnerEmail)): true}
+	participants := make([]InviteParticipantsToTripParams, 0, len(params.EmailsToInvite))
+	for _, eti := range params.EmailsToInvite {
+		key := strings.ToLower(string(eti))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		participants = append(participants, InviteParticipantsToTripParams{
 			TripID: tripID,
 			Email:  string(eti),
-		}
+		})
 	}
 
 	if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert participants for CreateTrip: %w", err)
 	}
 
+	if err := qtx.enqueue(ctx, OutboxConfirmTripOwner, OutboxPayload{TripID: tripID}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to enqueue email for CreateTrip: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w", err)
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit transaction for CreateTrip: %w", err)
 	}
 
 	return tripID, nil
@@ -58,17 +81,51 @@ func (q *Queries) PutTrip(ctx context.Context, pool *pgxpool.Pool, params spec.U
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	qtx := q.WithTx(tx)
+	// Activities are written under a share lock on their trip, so none can
+	// land outside the new dates between the check below and the update.
+	trip, err := qtx.GetTripForUpdate(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for PutTrip: %w", err)
+	}
+
+	if !trip.Status.Open() {
+		return ErrTripClosed
+	}
+
+	orphaned, err := qtx.GetOrphanedActivities(ctx, GetOrphanedActivitiesParams{
+		TripID:      tripID,
+		OldStartsAt: trip.StartsAt,
+		OldEndsAt:   trip.EndsAt,
+		StartsAt:    pgtype.Timestamptz{Valid: true, Time: params.StartsAt},
+		EndsAt:      pgtype.Timestamptz{Valid: true, Time: params.EndsAt},
+	})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get activities for PutTrip: %w", err)
+	}
+
+	if len(orphaned) > 0 {
+		return &OrphanedActivitiesError{Activities: orphaned}
+	}
+
 	if err := qtx.UpdateTrip(ctx, UpdateTripParams{
 		Destination: params.Destination,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		StartsAt:    pgtype.Timestamptz{Valid: true, Time: params.StartsAt},
+		EndsAt:      pgtype.Timestamptz{Valid: true, Time: params.EndsAt},
+		Timezone:    optionalTimezone(params.Timezone),
 		ID: tripID,
 	}); err != nil {
-		return fmt.Errorf("pgstore: failed to update trip for UpdateTrip: %w", err)
+		return fmt.Errorf("pgstore: failed to update trip for PutTrip: %w", err)
+	}
+
+	// Confirmed trips stay confirmed, only pending ones need a fresh link.
+	if !trip.Status.InvitationsSent() {
+		if err := qtx.enqueue(ctx, OutboxReconfirmTripOwner, OutboxPayload{TripID: tripID}); err != nil {
+			return fmt.Errorf("pgstore: failed to enqueue email for PutTrip: %w", err)
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("pgstore: failed to commit transaction for UpdateTrip: %w", err)
+		return fmt.Errorf("pgstore: failed to commit transaction for PutTrip: %w", err)
 	}
 
 	return nil
@@ -83,17 +140,26 @@ func (q *Queries) InsertActivity(ctx context.Context, pool *pgxpool.Pool, params
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	qtx := q.WithTx(tx)
+	trip, err := qtx.GetTripForShare(ctx, tripID)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to get trip for InsertActivity: %w", err)
+	}
+
+	if err := checkActivityTime(trip, params.OccursAt); err != nil {
+		return uuid.UUID{}, err
+	}
+
 	activityID, err := qtx.CreateActivity(ctx, CreateActivityParams{
 		TripID: tripID,
 		Title: params.Title,
-		OccursAt: pgtype.Timestamp{Valid: true, Time: params.OccursAt},
+		OccursAt: pgtype.Timestamptz{Valid: true, Time: params.OccursAt},
 	})
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity for CreateActivity: %w", err)
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity for InsertActivity: %w", err)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for InsertActivity: %w", err)
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit transaction for InsertActivity: %w", err)
 	}
 
 	return activityID, nil
@@ -108,42 +174,535 @@ func (q *Queries) InsertInviteParticipantToTrip(ctx context.Context, pool *pgxpo
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	qtx := q.WithTx(tx)
+	// Locked like in BulkInviteParticipantsToTrip, so a batch and a single
+	// invite are checked one after the other.
+	trip, err := qtx.GetTripForUpdate(ctx, tripID)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to get trip for InsertInviteParticipantToTrip: %w", err)
+	}
+
+	if !trip.Status.Open() {
+		return uuid.UUID{}, ErrTripClosed
+	}
+
 	participantID, err := qtx.InviteParticipantToTrip(ctx, InviteParticipantToTripParams{
 		TripID: tripID,
 		Email:  string(params.Email),
+		Name:   optionalText(params.Name),
+		Phone:  optionalText(params.Phone),
+		Notes:  optionalText(params.Notes),
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The insert hit the (trip_id, lower(email)) unique index.
+		existingID, err := qtx.GetTripParticipantIDByEmail(ctx, GetTripParticipantIDByEmailParams{
+			TripID: tripID,
+			Email:  string(params.Email),
+		})
+		if err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to get existing participant for InsertInviteParticipantToTrip: %w", err)
+		}
+		return uuid.UUID{}, &DuplicateParticipantError{ParticipantID: existingID}
+	}
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity for InviteParticipantToTrip: %w", err)
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert participant for InsertInviteParticipantToTrip: %w", err)
+	}
+
+	if trip.Status.InvitationsSent() {
+		if err := qtx.enqueue(ctx, OutboxTripInvitation, OutboxPayload{TripID: tripID, ParticipantID: participantID}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to enqueue email for InsertInviteParticipantToTrip: %w", err)
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for InsertInviteParticipantToTrip: %w", err)
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit transaction for InsertInviteParticipantToTrip: %w", err)
 	}
 
 	return participantID, nil
 }
 
-func (q *Queries) InsertTripsTripIDLinks(ctx context.Context, pool *pgxpool.Pool, params spec.CreateLinkRequest, tripID uuid.UUID) (uuid.UUID, error) {
+// PatchActivity changes the fields of an activity that are set in params. A
+// new time must fall within the trip, which is checked against the trip as it
+// is when the change commits. It returns pgx.ErrNoRows when the activity does
+// not belong to the trip.
+func (q *Queries) PatchActivity(ctx context.Context, pool *pgxpool.Pool, params spec.PatchActivityRequest, tripID uuid.UUID, activityID uuid.UUID) error {
 	tx, err := pool.Begin(ctx)
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for InsertTripsTripIDLinks: %w", err)
+		return fmt.Errorf("pgstore: failed to begin tx for PatchActivity: %w", err)
 	}
 
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	qtx := q.WithTx(tx)
-	linkId, err := qtx.CreateTripLink(ctx, CreateTripLinkParams{
-		TripID: tripID,
-		Title:  string(params.Title),
-		Url:    string(params.URL),
+	occursAt := pgtype.Timestamptz{}
+	if params.OccursAt != nil {
+		trip, err := qtx.GetTripForShare(ctx, tripID)
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to get trip for PatchActivity: %w", err)
+		}
+
+		if err := checkActivityTime(trip, *params.OccursAt); err != nil {
+			return err
+		}
+
+		occursAt = pgtype.Timestamptz{Valid: true, Time: *params.OccursAt}
+	}
+
+	n, err := qtx.UpdateActivity(ctx, UpdateActivityParams{
+		Title:    optionalText(params.Title),
+		OccursAt: occursAt,
+		ID:       activityID,
+		TripID:   tripID,
 	})
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity for InsertTripsTripIDLinks: %w", err)
+		return fmt.Errorf("pgstore: failed to update activity for PatchActivity: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("pgstore: failed to update activity for PatchActivity: %w", pgx.ErrNoRows)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for PatchActivity: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTrip removes a trip that was never confirmed, along with
+// everything that cascades from it and the e-mails still queued for it. It
+// returns pgx.ErrNoRows when there is no such draft.
+func (q *Queries) DeleteTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for DeleteTrip: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	if _, err := qtx.DeleteDraftTrip(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete trip for DeleteTrip: %w", err)
+	}
+
+	if err := qtx.CancelTripOutboxMessages(ctx, tripID.String()); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel emails for DeleteTrip: %w", err)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for InsertTripsTripIDLinks: %w", err)
+		return fmt.Errorf("pgstore: failed to commit transaction for DeleteTrip: %w", err)
+	}
+
+	return nil
+}
+
+// CancelTrip marks a confirmed trip as cancelled and lets every participant
+// know. Invitations that were still queued are dropped. It fails like
+// TransitionTrip when the trip can't be cancelled.
+func (q *Queries) CancelTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CancelTripRequest, tripID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for CancelTrip: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	if err := qtx.TransitionTrip(ctx, tripID, TripStatusCancelled); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel trip for CancelTrip: %w", err)
+	}
+
+	if err := qtx.MarkTripCancelled(ctx, MarkTripCancelledParams{
+		ID:                 tripID,
+		CancellationReason: optionalText(params.Reason),
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to record cancellation for CancelTrip: %w", err)
+	}
+
+	if err := qtx.CancelTripOutboxMessages(ctx, tripID.String()); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel emails for CancelTrip: %w", err)
+	}
+
+	participants, err := qtx.GetParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participants for CancelTrip: %w", err)
+	}
+
+	notices := make([]OutboxPayload, len(participants))
+	for i, participant := range participants {
+		notices[i] = OutboxPayload{TripID: tripID, ParticipantID: participant.ID}
+	}
+
+	if len(notices) > 0 {
+		if err := qtx.enqueueBatch(ctx, OutboxTripCancelled, notices); err != nil {
+			return fmt.Errorf("pgstore: failed to enqueue emails for CancelTrip: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for CancelTrip: %w", err)
+	}
+
+	return nil
+}
+
+// InviteOutcome reports what happened to one row of a bulk invite.
+type InviteOutcome struct {
+	ParticipantID uuid.UUID
+	// Duplicate is set when the e-mail was already invited to the trip, or
+	// appeared earlier in the same batch. ParticipantID then points at that
+	// participant.
+	Duplicate bool
+}
+
+// BulkInviteParticipantsToTrip invites every row through a single COPY and
+// returns one outcome per row, in order. Rows must already be validated.
+func (q *Queries) BulkInviteParticipantsToTrip(ctx context.Context, pool *pgxpool.Pool, rows []spec.BulkInviteRow, tripID uuid.UUID) ([]InviteOutcome, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for BulkInviteParticipantsToTrip: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	// Locking the trip keeps a confirmation or a cancellation from
+	// interleaving with the batch, and single invites from racing it.
+	trip, err := qtx.GetTripForUpdate(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to get trip for BulkInviteParticipantsToTrip: %w", err)
+	}
+
+	if !trip.Status.Open() {
+		return nil, ErrTripClosed
+	}
+
+	outcomes, err := q.copyInvitations(ctx, tx, rows, tripID)
+	if isUniqueViolation(err, participantEmailKey) {
+		// Someone was invited between the check and the COPY. Checked again,
+		// their row is reported as a duplicate like any other.
+		outcomes, err = q.copyInvitations(ctx, tx, rows, tripID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to insert participants for BulkInviteParticipantsToTrip: %w", err)
+	}
+
+	// COPY does not return the generated ids, read them back.
+	all, err := qtx.GetParticipants(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to get participants for BulkInviteParticipantsToTrip: %w", err)
+	}
+
+	ids := make(map[string]uuid.UUID, len(all))
+	for _, participant := range all {
+		ids[strings.ToLower(participant.Email)] = participant.ID
+	}
+
+	var invitations []OutboxPayload
+	for i, row := range rows {
+		outcomes[i].ParticipantID = ids[strings.ToLower(row.Email)]
+		if !outcomes[i].Duplicate {
+			invitations = append(invitations, OutboxPayload{TripID: tripID, ParticipantID: outcomes[i].ParticipantID})
+		}
+	}
+
+	if trip.Status.InvitationsSent() && len(invitations) > 0 {
+		if err := qtx.enqueueBatch(ctx, OutboxTripInvitation, invitations); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to enqueue emails for BulkInviteParticipantsToTrip: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit transaction for BulkInviteParticipantsToTrip: %w", err)
+	}
+
+	return outcomes, nil
+}
+
+// copyInvitations COPYs the rows whose e-mail is not on the trip yet, nor
+// earlier in rows, and marks the others as duplicates. The COPY runs in a
+// savepoint so that tx stays usable when it fails.
+func (q *Queries) copyInvitations(ctx context.Context, tx pgx.Tx, rows []spec.BulkInviteRow, tripID uuid.UUID) ([]InviteOutcome, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = savepoint.Rollback(ctx) }()
+
+	qsp := q.WithTx(savepoint)
+	existing, err := qsp.GetParticipants(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(existing)+len(rows))
+	for _, participant := range existing {
+		seen[strings.ToLower(participant.Email)] = true
+	}
+
+	outcomes := make([]InviteOutcome, len(rows))
+	participants := make([]InviteParticipantsToTripParams, 0, len(rows))
+	for i, row := range rows {
+		key := strings.ToLower(row.Email)
+		if seen[key] {
+			outcomes[i].Duplicate = true
+			continue
+		}
+		seen[key] = true
+
+		participants = append(participants, InviteParticipantsToTripParams{
+			TripID: tripID,
+			Email:  row.Email,
+			Name:   optionalText(row.Name),
+		})
+	}
+
+	if len(participants) > 0 {
+		if _, err := qsp.InviteParticipantsToTrip(ctx, participants); err != nil {
+			return nil, err
+		}
+	}
+
+	return outcomes, savepoint.Commit(ctx)
+}
+
+// InsertTripsTripIDLinks adds a link to a trip, recording addedBy, the e-mail
+// of the signed in member adding it, as its author.
+func (q *Queries) InsertTripsTripIDLinks(ctx context.Context, params spec.CreateLinkRequest, tripID uuid.UUID, addedBy string) (uuid.UUID, error) {
+	linkId, err := q.CreateTripLink(ctx, CreateTripLinkParams{
+		TripID:      tripID,
+		Title:       params.Title,
+		Url:         params.URL,
+		Description: optionalText(params.Description),
+		Category:    optionalLinkCategory(params.Category),
+		SortOrder:   sortOrder(params.SortOrder),
+		AddedBy:     pgtype.Text{String: addedBy, Valid: true},
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert link for InsertTripsTripIDLinks: %w", err)
 	}
 
 	return linkId, nil
-}
\ No newline at end of file
+}
+
+func (q *Queries) ConfirmTripWithToken(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, tokenHash []byte) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ConfirmTripWithToken: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	token, err := qtx.ConsumeConfirmationToken(ctx, ConsumeConfirmationTokenParams{
+		TokenHash: tokenHash,
+		Purpose:   string(tokens.PurposeTripConfirm),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidConfirmationToken
+		}
+		return fmt.Errorf("pgstore: failed to consume token for ConfirmTripWithToken: %w", err)
+	}
+
+	if token.TripID != tripID {
+		return ErrInvalidConfirmationToken
+	}
+
+	if err := qtx.TransitionTrip(ctx, tripID, TripStatusConfirmed); err != nil {
+		return fmt.Errorf("pgstore: failed to confirm trip for ConfirmTripWithToken: %w", err)
+	}
+
+	participants, err := qtx.GetParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participants for ConfirmTripWithToken: %w", err)
+	}
+
+	for _, participant := range participants {
+		if err := qtx.enqueue(ctx, OutboxTripInvitation, OutboxPayload{TripID: tripID, ParticipantID: participant.ID}); err != nil {
+			return fmt.Errorf("pgstore: failed to enqueue email for ConfirmTripWithToken: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for ConfirmTripWithToken: %w", err)
+	}
+
+	return nil
+}
+
+// RespondToInvitationWithToken records the participant's answer to an
+// invitation. The same invitation token is accepted for every answer. Maybe
+// leaves it valid so the participant can make up their mind later, accepting
+// or declining uses it up.
+//
+// It returns ErrInvalidConfirmationToken when the token can't be used and
+// ErrTripClosed when the trip was cancelled or archived.
+func (q *Queries) RespondToInvitationWithToken(ctx context.Context, pool *pgxpool.Pool, participantID uuid.UUID, tokenHash []byte, status RsvpStatus, response spec.ConfirmParticipantRequest) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RespondToInvitationWithToken: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	tokenParams := GetConfirmationTokenParams{
+		TokenHash: tokenHash,
+		Purpose:   string(tokens.PurposeParticipantConfirm),
+	}
+	token, err := qtx.GetConfirmationToken(ctx, tokenParams)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidConfirmationToken
+		}
+		return fmt.Errorf("pgstore: failed to get token for RespondToInvitationWithToken: %w", err)
+	}
+
+	if !token.ParticipantID.Valid || uuid.UUID(token.ParticipantID.Bytes) != participantID {
+		return ErrInvalidConfirmationToken
+	}
+
+	// Locking the trip orders the answer after a cancellation or another
+	// answer with the same token, so the token is read again once it is held.
+	trip, err := qtx.GetTripForUpdate(ctx, token.TripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for RespondToInvitationWithToken: %w", err)
+	}
+
+	if !trip.Status.Open() {
+		return ErrTripClosed
+	}
+
+	if status == RsvpStatusMaybe {
+		_, err = qtx.GetConfirmationToken(ctx, tokenParams)
+	} else {
+		_, err = qtx.ConsumeConfirmationToken(ctx, ConsumeConfirmationTokenParams(tokenParams))
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidConfirmationToken
+		}
+		return fmt.Errorf("pgstore: failed to consume token for RespondToInvitationWithToken: %w", err)
+	}
+
+	if err := qtx.RespondToInvitation(ctx, RespondToInvitationParams{
+		RsvpStatus:  status,
+		RsvpComment: optionalText(response.Comment),
+		Name:        optionalText(response.Name),
+		Phone:       optionalText(response.Phone),
+		Notes:       optionalText(response.Notes),
+		ID:          participantID,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to record answer for RespondToInvitationWithToken: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for RespondToInvitationWithToken: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveParticipant takes a participant off a trip. Their outstanding
+// confirmation tokens go with them, and so do any e-mails still waiting to be
+// sent to them.
+func (q *Queries) RemoveParticipant(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID, notify bool) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RemoveParticipant: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	trip, err := qtx.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for RemoveParticipant: %w", err)
+	}
+
+	participant, err := qtx.DeleteParticipant(ctx, DeleteParticipantParams{ID: participantID, TripID: tripID})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to delete participant for RemoveParticipant: %w", err)
+	}
+
+	if err := qtx.CancelParticipantOutboxMessages(ctx, participantID.String()); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel emails for RemoveParticipant: %w", err)
+	}
+
+	// Participants of a trip that was never confirmed have not heard of it yet.
+	if notify && trip.Status.InvitationsSent() {
+		if err := qtx.enqueue(ctx, OutboxParticipantRemoved, OutboxPayload{
+			TripID: tripID,
+			Email:  participant.Email,
+			Name:   participant.Name.String,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to enqueue email for RemoveParticipant: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for RemoveParticipant: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeInvitation is RemoveParticipant restricted to invitations that were
+// not answered yet. It returns pgx.ErrNoRows when there is no such invitation.
+func (q *Queries) RevokeInvitation(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participantID uuid.UUID, notify bool) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RevokeInvitation: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	trip, err := qtx.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for RevokeInvitation: %w", err)
+	}
+
+	participant, err := qtx.DeletePendingParticipant(ctx, DeletePendingParticipantParams{ID: participantID, TripID: tripID})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to delete invitation for RevokeInvitation: %w", err)
+	}
+
+	if err := qtx.CancelParticipantOutboxMessages(ctx, participantID.String()); err != nil {
+		return fmt.Errorf("pgstore: failed to cancel emails for RevokeInvitation: %w", err)
+	}
+
+	if notify && trip.Status.InvitationsSent() {
+		if err := qtx.enqueue(ctx, OutboxInvitationRevoked, OutboxPayload{
+			TripID: tripID,
+			Email:  participant.Email,
+			Name:   participant.Name.String,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to enqueue email for RevokeInvitation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for RevokeInvitation: %w", err)
+	}
+
+	return nil
+}
+
+// checkActivityTime returns an *ActivityOutsideTripError unless t falls
+// within the trip.
+func checkActivityTime(trip Trip, t time.Time) error {
+	if t.Before(trip.StartsAt.Time) || t.After(trip.EndsAt.Time) {
+		loc := trip.Location()
+		return &ActivityOutsideTripError{StartsAt: trip.StartsAt.Time.In(loc), EndsAt: trip.EndsAt.Time.In(loc)}
+	}
+	return nil
+}
+
+// optionalText maps an omitted request field to NULL.
+func optionalText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
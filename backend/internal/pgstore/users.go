@@ -0,0 +1,139 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RequestMagicLink queues a sign-in link for email. The token is issued when
+// the e-mail is sent, so it never sits in the outbox.
+func (q *Queries) RequestMagicLink(ctx context.Context, email string) error {
+	if err := q.enqueue(ctx, OutboxMagicLink, OutboxPayload{Email: email}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue email for RequestMagicLink: %w", err)
+	}
+	return nil
+}
+
+// SignInWithToken consumes a magic-link token and returns the user it signs
+// in, creating them on their first sign in. Trips and participations under
+// the user's e-mail that are not linked to an account yet are linked to
+// theirs. It returns ErrInvalidLoginToken when the token can't be used.
+func (q *Queries) SignInWithToken(ctx context.Context, pool *pgxpool.Pool, loginID uuid.UUID, tokenHash []byte) (User, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to begin tx for SignInWithToken: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	email, err := qtx.ConsumeLoginToken(ctx, ConsumeLoginTokenParams{ID: loginID, TokenHash: tokenHash})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ErrInvalidLoginToken
+		}
+		return User{}, fmt.Errorf("pgstore: failed to consume token for SignInWithToken: %w", err)
+	}
+
+	user, err := qtx.UpsertUser(ctx, email)
+	if err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to upsert user for SignInWithToken: %w", err)
+	}
+
+	if err := qtx.linkUser(ctx, user); err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to link account for SignInWithToken: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to commit transaction for SignInWithToken: %w", err)
+	}
+
+	return user, nil
+}
+
+// Identity is an account at an OpenID Connect provider, as its ID token
+// describes it.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// SignInWithIdentity returns the user an account at a provider signs in. The
+// first time the account is seen it is linked to the user holding its
+// e-mail, created if need be, which the provider must have verified. Trips
+// and participations are linked like with SignInWithToken. It returns
+// ErrUnverifiedEmail when a new account's e-mail can't be trusted.
+func (q *Queries) SignInWithIdentity(ctx context.Context, pool *pgxpool.Pool, identity Identity) (User, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to begin tx for SignInWithIdentity: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	email := identity.Email
+	userID, err := qtx.GetIdentityUserID(ctx, GetIdentityUserIDParams{Issuer: identity.Issuer, Subject: identity.Subject})
+	switch {
+	case err == nil:
+		// A known account keeps signing in as the same user, even after its
+		// e-mail changed at the provider.
+		known, err := qtx.GetUser(ctx, userID)
+		if err != nil {
+			return User{}, fmt.Errorf("pgstore: failed to get user for SignInWithIdentity: %w", err)
+		}
+		email = known.Email
+	case errors.Is(err, pgx.ErrNoRows):
+		if !identity.EmailVerified || email == "" {
+			return User{}, ErrUnverifiedEmail
+		}
+	default:
+		return User{}, fmt.Errorf("pgstore: failed to get identity for SignInWithIdentity: %w", err)
+	}
+
+	user, err := qtx.UpsertUser(ctx, email)
+	if err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to upsert user for SignInWithIdentity: %w", err)
+	}
+
+	if err := qtx.CreateUserIdentity(ctx, CreateUserIdentityParams{
+		UserID:  user.ID,
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+	}); err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to create identity for SignInWithIdentity: %w", err)
+	}
+
+	if err := qtx.linkUser(ctx, user); err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to link account for SignInWithIdentity: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return User{}, fmt.Errorf("pgstore: failed to commit transaction for SignInWithIdentity: %w", err)
+	}
+
+	return user, nil
+}
+
+// linkUser links the trips and participations under the user's e-mail that
+// are not linked to an account yet to theirs.
+func (q *Queries) linkUser(ctx context.Context, user User) error {
+	userID := pgtype.UUID{Bytes: user.ID, Valid: true}
+	if _, err := q.LinkUserTrips(ctx, LinkUserTripsParams{UserID: userID, Email: user.Email}); err != nil {
+		return fmt.Errorf("failed to link trips: %w", err)
+	}
+
+	if _, err := q.LinkUserParticipants(ctx, LinkUserParticipantsParams{UserID: userID, Email: user.Email}); err != nil {
+		return fmt.Errorf("failed to link participants: %w", err)
+	}
+
+	return nil
+}
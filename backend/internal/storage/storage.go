@@ -0,0 +1,84 @@
+// Package storage keeps the files attached to trips.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// ErrNotFound is returned when no object is stored under a key.
+var ErrNotFound = errors.New("storage: object not found")
+
+// Storage stores objects under slash separated keys. Implementations must be
+// safe for concurrent use.
+type Storage interface {
+	// Put stores the size bytes read from r under key, replacing any object
+	// already there.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the object stored under key, the caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing object
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+type Backend string
+
+const (
+	BackendLocal Backend = "local"
+	BackendS3    Backend = "s3"
+)
+
+type Config struct {
+	Backend Backend
+	// Dir is the directory the local backend writes into.
+	Dir string
+	S3  S3Config
+}
+
+// ConfigFromEnv reads the storage configuration from the environment. Files
+// are kept on the local disk unless SWALLOWGO_STORAGE_BACKEND says otherwise.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Backend: Backend(envOr("SWALLOWGO_STORAGE_BACKEND", string(BackendLocal))),
+		Dir:     envOr("SWALLOWGO_STORAGE_DIR", "./uploads"),
+		S3: S3Config{
+			Endpoint:        os.Getenv("SWALLOWGO_S3_ENDPOINT"),
+			Region:          envOr("SWALLOWGO_S3_REGION", "us-east-1"),
+			Bucket:          os.Getenv("SWALLOWGO_S3_BUCKET"),
+			AccessKeyID:     os.Getenv("SWALLOWGO_S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("SWALLOWGO_S3_SECRET_ACCESS_KEY"),
+		},
+	}
+
+	pathStyle, err := strconv.ParseBool(envOr("SWALLOWGO_S3_PATH_STYLE", "false"))
+	if err != nil {
+		return Config{}, fmt.Errorf("storage: invalid SWALLOWGO_S3_PATH_STYLE: %w", err)
+	}
+	cfg.S3.PathStyle = pathStyle
+
+	return cfg, nil
+}
+
+// New builds the Storage selected by cfg.Backend.
+func New(cfg Config) (Storage, error) {
+	switch cfg.Backend {
+	case BackendLocal:
+		return NewLocal(cfg.Dir)
+	case BackendS3:
+		return NewS3(cfg.S3)
+	default:
+		return nil, fmt.Errorf("storage: unknown backend %q", cfg.Backend)
+	}
+}
+
+func envOr(key string, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
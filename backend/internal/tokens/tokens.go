@@ -0,0 +1,123 @@
+// Package tokens issues and verifies the signed confirmation tokens that are
+// embedded in the e-mails sent to trip owners and participants.
+package tokens
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Purpose string
+
+const (
+	PurposeTripConfirm        Purpose = "trip_confirm"
+	PurposeParticipantConfirm Purpose = "participant_confirm"
+	PurposeLogin              Purpose = "login"
+	PurposeOwnershipTransfer  Purpose = "ownership_transfer"
+)
+
+const DefaultTTL = 72 * time.Hour
+
+// LoginTTL is how long a magic sign-in link stays valid. Unlike a
+// confirmation, it hands out a session, so it is kept short.
+const LoginTTL = 15 * time.Minute
+
+var (
+	ErrMalformed = errors.New("tokens: malformed token")
+	ErrSignature = errors.New("tokens: invalid token signature")
+	ErrExpired   = errors.New("tokens: token expired")
+)
+
+const nonceSize = 16
+
+var encoding = base64.RawURLEncoding
+
+type Token struct {
+	Value     string
+	Hash      []byte
+	ExpiresAt time.Time
+}
+
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewSigner(secret []byte, ttl time.Duration) Signer {
+	return Signer{secret, ttl}
+}
+
+// WithTTL returns a signer issuing tokens that live for ttl. Tokens carry
+// their own expiry, either signer verifies them.
+func (s Signer) WithTTL(ttl time.Duration) Signer {
+	return Signer{s.secret, ttl}
+}
+
+// Issue creates a new token bound to purpose and subjectID. Only the hash of
+// the returned token should be persisted, the value goes into the e-mail.
+func (s Signer) Issue(purpose Purpose, subjectID uuid.UUID) (Token, error) {
+	payload := make([]byte, nonceSize+8)
+	if _, err := rand.Read(payload[:nonceSize]); err != nil {
+		return Token{}, fmt.Errorf("tokens: failed to read nonce: %w", err)
+	}
+
+	expiresAt := time.Now().Add(s.ttl).Truncate(time.Second)
+	binary.BigEndian.PutUint64(payload[nonceSize:], uint64(expiresAt.Unix()))
+
+	value := encoding.EncodeToString(payload) + "." + encoding.EncodeToString(s.sign(purpose, subjectID, payload))
+	return Token{Value: value, Hash: Hash(value), ExpiresAt: expiresAt}, nil
+}
+
+// Verify checks that value was issued by this signer for purpose and
+// subjectID and that it has not expired. It does not check whether the token
+// was already used, that is up to the store.
+func (s Signer) Verify(value string, purpose Purpose, subjectID uuid.UUID) error {
+	rawPayload, rawSig, ok := strings.Cut(value, ".")
+	if !ok {
+		return ErrMalformed
+	}
+
+	payload, err := encoding.DecodeString(rawPayload)
+	if err != nil || len(payload) != nonceSize+8 {
+		return ErrMalformed
+	}
+
+	sig, err := encoding.DecodeString(rawSig)
+	if err != nil {
+		return ErrMalformed
+	}
+
+	if !hmac.Equal(sig, s.sign(purpose, subjectID, payload)) {
+		return ErrSignature
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[nonceSize:])), 0)
+	if time.Now().After(expiresAt) {
+		return ErrExpired
+	}
+
+	return nil
+}
+
+func (s Signer) sign(purpose Purpose, subjectID uuid.UUID, payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(purpose))
+	mac.Write(subjectID[:])
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// Hash returns the digest under which a token is stored.
+func Hash(value string) []byte {
+	sum := sha256.Sum256([]byte(value))
+	return sum[:]
+}
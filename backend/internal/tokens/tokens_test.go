@@ -0,0 +1,132 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var testSecret = []byte("a-secret-of-at-least-32-bytes-long")
+
+func issue(t *testing.T, s Signer, purpose Purpose, subjectID uuid.UUID) Token {
+	t.Helper()
+
+	token, err := s.Issue(purpose, subjectID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestRoundTrip(t *testing.T) {
+	s := NewSigner(testSecret, DefaultTTL)
+	subjectID := uuid.New()
+
+	token := issue(t, s, PurposeTripConfirm, subjectID)
+	if err := s.Verify(token.Value, PurposeTripConfirm, subjectID); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if !bytes.Equal(token.Hash, Hash(token.Value)) {
+		t.Error("Hash differs from the hash of the value")
+	}
+	if until := time.Until(token.ExpiresAt); until <= DefaultTTL-time.Minute || until > DefaultTTL {
+		t.Errorf("expires in %v, want about %v", until, DefaultTTL)
+	}
+
+	// A signer with another TTL shares the secret, so it verifies the token too.
+	if err := s.WithTTL(LoginTTL).Verify(token.Value, PurposeTripConfirm, subjectID); err != nil {
+		t.Errorf("Verify with another TTL: %v", err)
+	}
+
+	if other := issue(t, s, PurposeTripConfirm, subjectID); other.Value == token.Value {
+		t.Error("two tokens for the same subject are equal")
+	}
+}
+
+func TestVerifyBinding(t *testing.T) {
+	s := NewSigner(testSecret, DefaultTTL)
+	subjectID := uuid.New()
+	token := issue(t, s, PurposeParticipantConfirm, subjectID)
+
+	tests := []struct {
+		name      string
+		signer    Signer
+		purpose   Purpose
+		subjectID uuid.UUID
+	}{
+		{name: "other purpose", signer: s, purpose: PurposeTripConfirm, subjectID: subjectID},
+		{name: "other subject", signer: s, purpose: PurposeParticipantConfirm, subjectID: uuid.New()},
+		{name: "other secret", signer: NewSigner([]byte("another-secret-of-32-bytes-or-more"), DefaultTTL), purpose: PurposeParticipantConfirm, subjectID: subjectID},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.signer.Verify(token.Value, tt.purpose, tt.subjectID); !errors.Is(err, ErrSignature) {
+				t.Fatalf("err = %v, want ErrSignature", err)
+			}
+		})
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	s := NewSigner(testSecret, DefaultTTL)
+	subjectID := uuid.New()
+	token := issue(t, s, PurposeLogin, subjectID)
+	rawPayload, rawSig, _ := strings.Cut(token.Value, ".")
+
+	sig, _ := encoding.DecodeString(rawSig)
+	sig[0] ^= 0xff
+	if err := s.Verify(rawPayload+"."+encoding.EncodeToString(sig), PurposeLogin, subjectID); !errors.Is(err, ErrSignature) {
+		t.Errorf("flipped signature: err = %v, want ErrSignature", err)
+	}
+
+	// Pushing the expiry out breaks the signature as well.
+	payload, _ := encoding.DecodeString(rawPayload)
+	binary.BigEndian.PutUint64(payload[nonceSize:], uint64(time.Now().Add(365*24*time.Hour).Unix()))
+	if err := s.Verify(encoding.EncodeToString(payload)+"."+rawSig, PurposeLogin, subjectID); !errors.Is(err, ErrSignature) {
+		t.Errorf("extended expiry: err = %v, want ErrSignature", err)
+	}
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	s := NewSigner(testSecret, DefaultTTL)
+	subjectID := uuid.New()
+	token := issue(t, s, PurposeTripConfirm, subjectID)
+	rawPayload, rawSig, _ := strings.Cut(token.Value, ".")
+
+	tests := map[string]string{
+		"empty":            "",
+		"no separator":     rawPayload + rawSig,
+		"payload not b64":  "***." + rawSig,
+		"signature no b64": rawPayload + ".***",
+		"short payload":    encoding.EncodeToString([]byte("short")) + "." + rawSig,
+		"padded":           rawPayload + "=." + rawSig,
+	}
+
+	for name, value := range tests {
+		if err := s.Verify(value, PurposeTripConfirm, subjectID); !errors.Is(err, ErrMalformed) {
+			t.Errorf("%s: err = %v, want ErrMalformed", name, err)
+		}
+	}
+}
+
+func TestVerifyExpired(t *testing.T) {
+	s := NewSigner(testSecret, DefaultTTL)
+	subjectID := uuid.New()
+
+	expired := issue(t, s.WithTTL(-time.Minute), PurposeLogin, subjectID)
+	if err := s.Verify(expired.Value, PurposeLogin, subjectID); !errors.Is(err, ErrExpired) {
+		t.Fatalf("err = %v, want ErrExpired", err)
+	}
+
+	// The signature is checked first, a forged token never reports expiry.
+	if err := s.Verify(expired.Value, PurposeTripConfirm, subjectID); !errors.Is(err, ErrSignature) {
+		t.Fatalf("other purpose: err = %v, want ErrSignature", err)
+	}
+}
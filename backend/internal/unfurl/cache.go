@@ -0,0 +1,99 @@
+package unfurl
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type CacheConfig struct {
+	// Size is the number of previews kept, the least recently used go first.
+	Size int
+	// TTL is how long a preview is served before the page is fetched again.
+	TTL time.Duration
+}
+
+var DefaultCacheConfig = CacheConfig{
+	Size: 1000,
+	TTL:  6 * time.Hour,
+}
+
+// Cache is a Fetcher that remembers the previews fetched through it, so a
+// URL shared by many trips is only fetched once. Failures are not cached.
+type Cache struct {
+	fetcher Fetcher
+	cfg     CacheConfig
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type cacheEntry struct {
+	url       string
+	preview   Preview
+	expiresAt time.Time
+}
+
+func NewCache(fetcher Fetcher, cfg CacheConfig) *Cache {
+	return &Cache{
+		fetcher: fetcher,
+		cfg:     cfg,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *Cache) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	if preview, ok := c.get(rawURL); ok {
+		return preview, nil
+	}
+
+	preview, err := c.fetcher.Fetch(ctx, rawURL)
+	if err != nil {
+		return Preview{}, err
+	}
+
+	c.put(rawURL, preview)
+	return preview, nil
+}
+
+func (c *Cache) get(rawURL string) (Preview, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[rawURL]
+	if !ok {
+		return Preview{}, false
+	}
+
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, rawURL)
+		return Preview{}, false
+	}
+
+	c.order.MoveToFront(el)
+	return entry.preview, true
+}
+
+func (c *Cache) put(rawURL string, preview Preview) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{url: rawURL, preview: preview, expiresAt: time.Now().Add(c.cfg.TTL)}
+	if el, ok := c.entries[rawURL]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[rawURL] = c.order.PushFront(entry)
+	for c.order.Len() > c.cfg.Size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).url)
+	}
+}
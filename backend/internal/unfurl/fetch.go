@@ -0,0 +1,224 @@
+// Package unfurl fetches the OpenGraph preview of the links added to trips.
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrBlockedAddress is returned for URLs that resolve to a loopback,
+	// private or otherwise non-public address.
+	ErrBlockedAddress = errors.New("unfurl: address is not public")
+	// ErrUnsupportedURL is returned for URLs that are not http or https.
+	ErrUnsupportedURL = errors.New("unfurl: only http and https URLs can be fetched")
+	// ErrNotHTML is returned when the page is not an HTML document.
+	ErrNotHTML = errors.New("unfurl: page is not html")
+)
+
+// StatusError is returned when the page answers with anything but 200 OK.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unfurl: page answered with status %d", e.StatusCode)
+}
+
+// Permanent reports whether fetching the same URL again is pointless.
+func Permanent(err error) bool {
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.StatusCode >= 400 && status.StatusCode < 500 && status.StatusCode != http.StatusTooManyRequests
+	}
+	return errors.Is(err, ErrBlockedAddress) || errors.Is(err, ErrUnsupportedURL) || errors.Is(err, ErrNotHTML)
+}
+
+// Preview is what a page says about itself. Fields the page does not set are
+// left empty.
+type Preview struct {
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+// Fetcher fetches the preview of a page.
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (Preview, error)
+}
+
+type FetchConfig struct {
+	// Timeout bounds a whole fetch, redirects included.
+	Timeout time.Duration
+	// MaxBytes is how much of a page is read looking for its preview.
+	MaxBytes int64
+	// MaxRedirects is how many redirects are followed.
+	MaxRedirects int
+	UserAgent    string
+}
+
+var DefaultFetchConfig = FetchConfig{
+	Timeout:      10 * time.Second,
+	MaxBytes:     1 << 20,
+	MaxRedirects: 5,
+	UserAgent:    "SwallowGo-LinkPreview/1.0",
+}
+
+// HTTPFetcher fetches previews over the internet. It refuses to connect to
+// anything but public addresses, checking the address actually dialed so a
+// DNS answer or a redirect can't point it at the internal network.
+type HTTPFetcher struct {
+	client *http.Client
+	cfg    FetchConfig
+	// allowPrivate turns the address check off, for tests against a local
+	// server.
+	allowPrivate bool
+}
+
+func NewHTTPFetcher(cfg FetchConfig) *HTTPFetcher {
+	f := &HTTPFetcher{cfg: cfg}
+
+	dialer := &net.Dialer{
+		Timeout: cfg.Timeout,
+		Control: f.checkAddress,
+	}
+	f.client = &http.Client{
+		Timeout: cfg.Timeout,
+		Transport: &http.Transport{
+			// A proxy would do the dialing, out of reach of checkAddress.
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   cfg.Timeout,
+			ResponseHeaderTimeout: cfg.Timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+			ForceAttemptHTTP2:     true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > cfg.MaxRedirects {
+				return fmt.Errorf("unfurl: stopped after %d redirects", cfg.MaxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrUnsupportedURL
+			}
+			return nil
+		},
+	}
+
+	return f
+}
+
+// newLocalFetcher is NewHTTPFetcher without the address check, for tests
+// against a server on the loopback interface.
+func newLocalFetcher(cfg FetchConfig) *HTTPFetcher {
+	f := NewHTTPFetcher(cfg)
+	f.allowPrivate = true
+	return f
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return Preview{}, ErrUnsupportedURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", f.cfg.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: failed to fetch page: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Preview{}, &StatusError{StatusCode: res.StatusCode}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return Preview{}, ErrNotHTML
+	}
+
+	// Relative image URLs are relative to where the redirects ended.
+	preview, err := parsePreview(io.LimitReader(res.Body, f.cfg.MaxBytes), res.Request.URL)
+	if err != nil {
+		return Preview{}, fmt.Errorf("unfurl: failed to parse page: %w", err)
+	}
+
+	return preview, nil
+}
+
+// checkAddress runs right before a connection is made, with the address the
+// host name resolved to.
+func (f *HTTPFetcher) checkAddress(network string, address string, _ syscall.RawConn) error {
+	if f.allowPrivate {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("unfurl: invalid address %q: %w", address, err)
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("unfurl: invalid address %q: %w", address, err)
+	}
+
+	if !isPublic(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+	}
+
+	return nil
+}
+
+// nonPublic lists the special-purpose ranges the netip predicates miss.
+var nonPublic = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, and broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, could wrap any IPv4
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local NAT64
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+}
+
+func isPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, prefix := range nonPublic {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
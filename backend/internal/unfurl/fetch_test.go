@@ -0,0 +1,226 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFetchParsesPreview(t *testing.T) {
+	tests := []struct {
+		name string
+		head string
+		want Preview
+	}{
+		{
+			name: "opengraph wins",
+			head: `<title>Plain title</title>
+<meta name="description" content="Plain description">
+<meta name="twitter:title" content="Twitter title">
+<meta property="og:title" content="  OpenGraph
+  title ">
+<meta property="og:description" content="OpenGraph description">
+<meta property="og:image" content="/images/main.jpg">
+<meta property="og:image" content="/images/second.jpg">
+<meta property="og:site_name" content="Example">`,
+			want: Preview{
+				Title:       "OpenGraph title",
+				Description: "OpenGraph description",
+				ImageURL:    "/images/main.jpg",
+				SiteName:    "Example",
+			},
+		},
+		{
+			name: "twitter card before the plain tags",
+			head: `<title>Plain title</title>
+<meta name="description" content="Plain description">
+<meta name="twitter:title" content="Twitter title">
+<meta name="twitter:description" content="Twitter description">
+<meta name="twitter:image" content="https://cdn.example.com/card.png">`,
+			want: Preview{
+				Title:       "Twitter title",
+				Description: "Twitter description",
+				ImageURL:    "https://cdn.example.com/card.png",
+			},
+		},
+		{
+			name: "plain title and description",
+			head: `<title>Plain
+title</title>
+<meta name="description" content="Plain description">
+<meta property="og:image" content="javascript:alert(1)">`,
+			want: Preview{
+				Title:       "Plain title",
+				Description: "Plain description",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				fmt.Fprintf(w, "<!DOCTYPE html><html><head>%s</head><body><title>Body title</title></body></html>", tt.head)
+			}))
+			defer srv.Close()
+
+			got, err := newLocalFetcher(DefaultFetchConfig).Fetch(context.Background(), srv.URL+"/page")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Relative images are resolved against the page.
+			if strings.HasPrefix(tt.want.ImageURL, "/") {
+				tt.want.ImageURL = srv.URL + tt.want.ImageURL
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchReadsAtMostMaxBytes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><head><title>Early</title><!-- %s --><meta property="og:title" content="Late"></head></html>`, strings.Repeat("x", 4096))
+	}))
+	defer srv.Close()
+
+	cfg := DefaultFetchConfig
+	cfg.MaxBytes = 1024
+	got, err := newLocalFetcher(cfg).Fetch(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "Early" {
+		t.Errorf("title = %q, want the one before the cut", got.Title)
+	}
+
+	cfg.MaxBytes = 1 << 20
+	got, err = newLocalFetcher(cfg).Fetch(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "Late" {
+		t.Errorf("title = %q, want the og:title once the page fits", got.Title)
+	}
+}
+
+func TestFetchFollowsAtMostMaxRedirects(t *testing.T) {
+	// /hop/n redirects n more times before serving the page.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/hop/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		if n > 0 {
+			http.Redirect(w, r, "/hop/"+strconv.Itoa(n-1), http.StatusFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<html><head><title>Arrived</title></head></html>")
+	}))
+	defer srv.Close()
+
+	cfg := DefaultFetchConfig
+	cfg.MaxRedirects = 2
+	f := newLocalFetcher(cfg)
+
+	got, err := f.Fetch(context.Background(), srv.URL+"/hop/2")
+	if err != nil {
+		t.Fatalf("two redirects: %v", err)
+	}
+	if got.Title != "Arrived" {
+		t.Errorf("title = %q, want Arrived", got.Title)
+	}
+
+	if _, err := f.Fetch(context.Background(), srv.URL+"/hop/3"); err == nil {
+		t.Fatal("three redirects: want an error")
+	}
+}
+
+func TestFetchRefusesNonHTML(t *testing.T) {
+	for _, contentType := range []string{"application/json", "image/png", ""} {
+		contentType := contentType
+		t.Run(contentType, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Set directly so an empty type is sent as is, not sniffed.
+				w.Header()["Content-Type"] = []string{contentType}
+				fmt.Fprint(w, `{"title": "not a page"}`)
+			}))
+			defer srv.Close()
+
+			_, err := newLocalFetcher(DefaultFetchConfig).Fetch(context.Background(), srv.URL)
+			if !errors.Is(err, ErrNotHTML) {
+				t.Fatalf("err = %v, want ErrNotHTML", err)
+			}
+			if !Permanent(err) {
+				t.Error("a page that is not html is not worth fetching again")
+			}
+		})
+	}
+}
+
+func TestFetchRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the fetcher connected to a loopback address")
+	}))
+	defer srv.Close()
+
+	_, err := NewHTTPFetcher(DefaultFetchConfig).Fetch(context.Background(), srv.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("err = %v, want ErrBlockedAddress", err)
+	}
+
+	// The check runs on the address dialed, whatever name or redirect led
+	// there.
+	for _, address := range []string{"10.0.0.7:80", "[::1]:443", "169.254.169.254:80"} {
+		if err := NewHTTPFetcher(DefaultFetchConfig).checkAddress("tcp", address, nil); !errors.Is(err, ErrBlockedAddress) {
+			t.Errorf("checkAddress(%s) = %v, want ErrBlockedAddress", address, err)
+		}
+	}
+	if err := newLocalFetcher(DefaultFetchConfig).checkAddress("tcp", "10.0.0.7:80", nil); err != nil {
+		t.Errorf("local fetcher refused a private address: %v", err)
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"64:ff9b::7f00:1", false},
+		{"2001:db8::1", false},
+	}
+
+	for _, tt := range tests {
+		if got := isPublic(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("isPublic(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package unfurl
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Longest values kept, in runes. Pages stuff all sorts of things in there.
+const (
+	maxTitleLength       = 300
+	maxDescriptionLength = 1000
+	maxSiteNameLength    = 100
+	maxImageURLLength    = 2048
+)
+
+// parsePreview reads the head of an HTML page. OpenGraph tags win over their
+// Twitter card equivalents, which win over the plain title and description.
+func parsePreview(r io.Reader, base *url.URL) (Preview, error) {
+	var (
+		og      = map[string]string{}
+		twitter = map[string]string{}
+		title   strings.Builder
+		inTitle bool
+		desc    string
+	)
+
+	z := html.NewTokenizer(r)
+loop:
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// A page cut short by the size limit still has a usable head.
+			if err := z.Err(); !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return Preview{}, err
+			}
+			break loop
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Body:
+				break loop
+			case atom.Title:
+				inTitle = true
+			case atom.Meta:
+				if !hasAttr {
+					continue
+				}
+				key, content := metaAttributes(z)
+				switch {
+				case strings.HasPrefix(key, "og:"):
+					setOnce(og, strings.TrimPrefix(key, "og:"), content)
+				case strings.HasPrefix(key, "twitter:"):
+					setOnce(twitter, strings.TrimPrefix(key, "twitter:"), content)
+				case key == "description" && desc == "":
+					desc = content
+				}
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Head:
+				break loop
+			case atom.Title:
+				inTitle = false
+			}
+		case html.TextToken:
+			if inTitle {
+				title.Write(z.Text())
+			}
+		}
+	}
+
+	return Preview{
+		Title:       clean(firstOf(og["title"], twitter["title"], title.String()), maxTitleLength),
+		Description: clean(firstOf(og["description"], twitter["description"], desc), maxDescriptionLength),
+		ImageURL:    imageURL(base, firstOf(og["image:secure_url"], og["image"], og["image:url"], twitter["image"], twitter["image:src"])),
+		SiteName:    clean(og["site_name"], maxSiteNameLength),
+	}, nil
+}
+
+// metaAttributes returns the name or property of a meta tag, lowercased, and
+// its content.
+func metaAttributes(z *html.Tokenizer) (key string, content string) {
+	for {
+		name, value, more := z.TagAttr()
+		switch string(name) {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(string(value)))
+			}
+		case "content":
+			content = string(value)
+		}
+		if !more {
+			return key, content
+		}
+	}
+}
+
+// setOnce keeps the first value of a tag, pages repeat og:image for every
+// picture they have and the first one is the main one.
+func setOnce(m map[string]string, key string, value string) {
+	if _, ok := m[key]; !ok && strings.TrimSpace(value) != "" {
+		m[key] = value
+	}
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// clean collapses whitespace and cuts s to at most n runes.
+func clean(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if runes := []rune(s); len(runes) > n {
+		s = strings.TrimSpace(string(runes[:n-1])) + "…"
+	}
+	return s
+}
+
+// imageURL resolves a possibly relative image URL against the page, keeping
+// only http and https ones.
+func imageURL(base *url.URL, raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+
+	u, err := base.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+
+	if s := u.String(); len(s) <= maxImageURLLength {
+		return s
+	}
+	return ""
+}
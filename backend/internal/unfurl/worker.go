@@ -0,0 +1,161 @@
+package unfurl
+
+import (
+	"SwallowGo/internal/pgstore"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type store interface {
+	ClaimLinkPreviews(ctx context.Context, arg pgstore.ClaimLinkPreviewsParams) ([]pgstore.ClaimLinkPreviewsRow, error)
+	SaveLinkPreview(ctx context.Context, arg pgstore.SaveLinkPreviewParams) error
+	RetryLinkPreview(ctx context.Context, arg pgstore.RetryLinkPreviewParams) error
+	FailLinkPreview(ctx context.Context, arg pgstore.FailLinkPreviewParams) error
+}
+
+type Config struct {
+	// PollInterval is how long the worker sleeps when no link is waiting
+	// for its preview.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of links claimed at once.
+	BatchSize int32
+	// Lease is how long a claimed link stays invisible to other workers.
+	Lease time.Duration
+	// MaxAttempts is the number of fetches tried before a preview is given up.
+	MaxAttempts int32
+	// BaseBackoff is doubled after every failed attempt, up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+var DefaultConfig = Config{
+	PollInterval: 2 * time.Second,
+	BatchSize:    10,
+	Lease:        time.Minute,
+	MaxAttempts:  4,
+	BaseBackoff:  30 * time.Second,
+	MaxBackoff:   30 * time.Minute,
+}
+
+// Worker fetches the previews of the links waiting for one and stores them
+// next to the link.
+type Worker struct {
+	store   store
+	fetcher Fetcher
+	logger  *zap.Logger
+	cfg     Config
+}
+
+func NewWorker(pool *pgxpool.Pool, fetcher Fetcher, logger *zap.Logger, cfg Config) Worker {
+	return Worker{pgstore.New(pool), fetcher, logger.Named("unfurl"), cfg}
+}
+
+// Run fetches pending previews until ctx is cancelled. A fetch cut short by
+// the cancellation is retried once its lease runs out.
+func (w Worker) Run(ctx context.Context) {
+	for {
+		n, err := w.unfurlBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			w.logger.Error("failed to unfurl links", zap.Error(err))
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if n == w.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.cfg.PollInterval):
+		}
+	}
+}
+
+func (w Worker) unfurlBatch(ctx context.Context) (int32, error) {
+	links, err := w.store.ClaimLinkPreviews(ctx, pgstore.ClaimLinkPreviewsParams{
+		LeaseSeconds: w.cfg.Lease.Seconds(),
+		BatchSize:    w.cfg.BatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unfurl: failed to claim links: %w", err)
+	}
+
+	for _, link := range links {
+		if ctx.Err() != nil {
+			break
+		}
+		w.unfurl(ctx, link)
+	}
+
+	return int32(len(links)), nil
+}
+
+// unfurl stores the preview of one link. Every update is conditioned on the
+// URL, a link edited while its old URL was being fetched is left pending for
+// the new one.
+func (w Worker) unfurl(ctx context.Context, link pgstore.ClaimLinkPreviewsRow) {
+	logger := w.logger.With(
+		zap.String("link_id", link.ID.String()),
+		zap.Int32("attempt", link.PreviewAttempts),
+	)
+
+	preview, fetchErr := w.fetcher.Fetch(ctx, link.Url)
+	if fetchErr == nil {
+		if err := w.store.SaveLinkPreview(ctx, pgstore.SaveLinkPreviewParams{
+			PreviewTitle:       optionalText(preview.Title),
+			PreviewDescription: optionalText(preview.Description),
+			PreviewImageUrl:    optionalText(preview.ImageURL),
+			PreviewSiteName:    optionalText(preview.SiteName),
+			ID:                 link.ID,
+			Url:                link.Url,
+		}); err != nil {
+			logger.Error("failed to save link preview", zap.Error(err))
+		}
+		return
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	if Permanent(fetchErr) || link.PreviewAttempts >= w.cfg.MaxAttempts {
+		logger.Info("giving up on link preview", zap.Error(fetchErr))
+		if err := w.store.FailLinkPreview(ctx, pgstore.FailLinkPreviewParams{ID: link.ID, Url: link.Url}); err != nil {
+			logger.Error("failed to mark link preview as failed", zap.Error(err))
+		}
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(w.backoff(link.PreviewAttempts))
+	logger.Warn("failed to fetch link preview, retrying", zap.Error(fetchErr), zap.Time("next_attempt_at", nextAttemptAt))
+	if err := w.store.RetryLinkPreview(ctx, pgstore.RetryLinkPreviewParams{
+		PreviewNextAttemptAt: pgtype.Timestamptz{Valid: true, Time: nextAttemptAt},
+		ID:                   link.ID,
+		Url:                  link.Url,
+	}); err != nil {
+		logger.Error("failed to reschedule link preview", zap.Error(err))
+	}
+}
+
+// backoff returns the delay before the next attempt, given how many attempts
+// were already made.
+func (w Worker) backoff(attempts int32) time.Duration {
+	delay := w.cfg.BaseBackoff
+	for i := int32(1); i < attempts && delay < w.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, w.cfg.MaxBackoff)
+}
+
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}